@@ -15,6 +15,8 @@ type FileSystem interface {
 	Getwd() (string, error)
 	Walk(root string, walkFn filepath.WalkFunc) error
 	ReadDir(path string) ([]os.DirEntry, error)
+	Rename(oldPath, newPath string) error
+	Remove(path string) error
 }
 
 // fileSystem implementa FileSystem usando os padrão
@@ -65,3 +67,11 @@ func (fs *fileSystem) Walk(root string, walkFn filepath.WalkFunc) error {
 func (fs *fileSystem) ReadDir(path string) ([]os.DirEntry, error) {
 	return os.ReadDir(path)
 }
+
+func (fs *fileSystem) Rename(oldPath, newPath string) error {
+	return os.Rename(oldPath, newPath)
+}
+
+func (fs *fileSystem) Remove(path string) error {
+	return os.Remove(path)
+}
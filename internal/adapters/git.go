@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Git interface para abstração de consultas ao repositório git
+type Git interface {
+	// ChangedFiles retorna arquivos adicionados ou modificados em dir desde a revisão rev
+	// (incluindo alterações não commitadas e arquivos não rastreados). Caminhos são
+	// retornados prefixados por dir.
+	ChangedFiles(dir string, rev string) ([]string, error)
+}
+
+// git implementa Git executando o binário git
+type git struct{}
+
+// NewGit cria uma nova instância de Git
+func NewGit() Git {
+	return &git{}
+}
+
+func (g *git) ChangedFiles(dir string, rev string) ([]string, error) {
+	if strings.HasPrefix(rev, "-") {
+		return nil, fmt.Errorf("revisão inválida: %s", rev)
+	}
+
+	diff, err := g.run(dir, "diff", "--name-only", "--relative", "--diff-filter=AMR", rev, "--", ".")
+	if err != nil {
+		return nil, err
+	}
+	untracked, err := g.run(dir, "ls-files", "--others", "--exclude-standard", "--", ".")
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	for _, line := range append(strings.Split(diff, "\n"), strings.Split(untracked, "\n")...) {
+		line = strings.TrimSpace(line)
+		if line == "" || seen[line] {
+			continue
+		}
+		seen[line] = true
+		files = append(files, filepath.Join(dir, filepath.FromSlash(line)))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// run executa um subcomando git em dir e retorna stdout
+func (g *git) run(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], msg)
+	}
+	return stdout.String(), nil
+}
@@ -45,12 +45,27 @@ func (r *Router) Run(args []string) int {
 	case "list":
 		listCmd := commands.NewListCommand(r.fs)
 		return listCmd.Execute(cmdArgs)
+	case "show":
+		showCmd := commands.NewShowCommand(r.fs)
+		return showCmd.Execute(cmdArgs)
 	case "check":
 		checkCmd := commands.NewCheckCommand(r.fs)
 		return checkCmd.Execute(cmdArgs)
 	case "view":
 		viewCmd := commands.NewViewCommand(r.fs)
 		return viewCmd.Execute(cmdArgs)
+	case "trace":
+		traceCmd := commands.NewTraceCommand(r.fs)
+		return traceCmd.Execute(cmdArgs)
+	case "coverage":
+		coverageCmd := commands.NewCoverageCommand(r.fs)
+		return coverageCmd.Execute(cmdArgs)
+	case "graph":
+		graphCmd := commands.NewGraphCommand(r.fs)
+		return graphCmd.Execute(cmdArgs)
+	case "mv", "rename":
+		mvCmd := commands.NewMvCommand(r.fs)
+		return mvCmd.Execute(cmdArgs)
 	case "config":
 		configCmd := commands.NewConfigCommand(r.fs)
 		return configCmd.Execute(cmdArgs)
@@ -77,9 +92,14 @@ func (r *Router) printUsage() {
 	fmt.Println("  init       Inicializa um novo projeto SDD")
 	fmt.Println("  update     Atualiza templates e arquivos base do projeto")
 	fmt.Println("  list       Lista todas as specs com status")
+	fmt.Println("  show       Exibe os detalhes de uma spec")
 	fmt.Println("  validate   Valida specs contra checklist formal")
 	fmt.Println("  check      Verifica consistência estrutural de specs")
 	fmt.Println("  view       Exibe dashboard com informações agregadas")
+	fmt.Println("  trace      Gera matriz de rastreabilidade de requisitos")
+	fmt.Println("  coverage   Mapeia anotações no código para requisitos das specs")
+	fmt.Println("  graph      Gera o grafo de dependências entre specs")
+	fmt.Println("  mv         Renomeia ou renumera uma spec e atualiza os links (alias: rename)")
 	fmt.Println("  config     Gerencia configuração do CLI")
 	fmt.Println("  version    Exibe a versão atual")
 	fmt.Println("  help       Exibe ajuda")
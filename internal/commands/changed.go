@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+// changedSpecFiles retorna specs (*.spec.md) adicionadas ou modificadas desde a revisão rev
+func changedSpecFiles(fs adapters.FileSystem, git adapters.Git, path string, rev string) ([]string, error) {
+	dir := path
+	if stat, err := fs.Stat(path); err == nil && !stat.IsDir() {
+		dir = filepath.Dir(path)
+	}
+
+	changed, err := git.ChangedFiles(dir, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(changed))
+	for _, file := range changed {
+		if strings.HasSuffix(file, ".spec.md") && fs.Exists(file) {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func TestChangedSpecFiles(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git não disponível")
+	}
+
+	fs := adapters.NewFileSystem()
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "specs")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	gitRun := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", tmpDir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	unchanged := filepath.Join(specsDir, "01-base.spec.md")
+	modified := filepath.Join(specsDir, "02-modified.spec.md")
+	added := filepath.Join(specsDir, "03-added.spec.md")
+
+	gitRun("init", "-q")
+	for _, file := range []string{unchanged, modified} {
+		if err := fs.WriteFile(file, []byte("# Spec"), 0644); err != nil {
+			t.Fatalf("falha ao criar spec: %v", err)
+		}
+	}
+	gitRun("add", ".")
+	gitRun("commit", "-q", "-m", "base")
+
+	if err := fs.WriteFile(modified, []byte("# Spec alterada"), 0644); err != nil {
+		t.Fatalf("falha ao alterar spec: %v", err)
+	}
+	if err := fs.WriteFile(added, []byte("# Spec nova"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+	if err := fs.WriteFile(filepath.Join(specsDir, "notas.md"), []byte("x"), 0644); err != nil {
+		t.Fatalf("falha ao criar arquivo: %v", err)
+	}
+
+	files, err := changedSpecFiles(fs, adapters.NewGit(), specsDir, "HEAD")
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	expected := []string{modified, added}
+	if len(files) != len(expected) {
+		t.Fatalf("esperado %v, obtido %v", expected, files)
+	}
+	for i := range expected {
+		if files[i] != expected[i] {
+			t.Errorf("esperado %v, obtido %v", expected, files)
+		}
+	}
+
+	if _, err := changedSpecFiles(fs, adapters.NewGit(), specsDir, "revisao-inexistente"); err == nil {
+		t.Error("deveria retornar erro para revisão inexistente")
+	}
+}
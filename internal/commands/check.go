@@ -4,26 +4,38 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dreibox/specs/internal/adapters"
+	baselineSvc "github.com/dreibox/specs/internal/services/baseline"
 	checkerSvc "github.com/dreibox/specs/internal/services/checker"
 	configSvc "github.com/dreibox/specs/internal/services/config"
+	linkcheckerSvc "github.com/dreibox/specs/internal/services/linkchecker"
+	numberingSvc "github.com/dreibox/specs/internal/services/numbering"
+	validatorSvc "github.com/dreibox/specs/internal/services/validator"
 )
 
 // CheckCommand implementa o comando check
 type CheckCommand struct {
-	fs          adapters.FileSystem
-	checkerSvc  *checkerSvc.Service
-	configSvc   *configSvc.Service
+	fs           adapters.FileSystem
+	checkerSvc   *checkerSvc.Service
+	configSvc    *configSvc.Service
+	validatorSvc *validatorSvc.Service
+	baselineSvc  *baselineSvc.Service
+	git          adapters.Git
 }
 
 // NewCheckCommand cria uma nova instância do CheckCommand
 func NewCheckCommand(fs adapters.FileSystem) *CheckCommand {
 	return &CheckCommand{
-		fs:         fs,
-		checkerSvc: checkerSvc.NewService(fs),
-		configSvc:  configSvc.NewService(fs),
+		fs:           fs,
+		checkerSvc:   checkerSvc.NewService(fs),
+		configSvc:    configSvc.NewService(fs),
+		validatorSvc: validatorSvc.NewService(fs),
+		baselineSvc:  baselineSvc.NewService(fs),
+		git:          adapters.NewGit(),
 	}
 }
 
@@ -42,6 +54,13 @@ func (c *CheckCommand) Execute(args []string) int {
 		return 0
 	}
 
+	// Template de saída (--template ou --template-file)
+	tmpl, err := loadReportTemplate(c.fs, opts.Template)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
 	// Resolver caminho padrão se não fornecido
 	path := opts.Path
 	if path == "" {
@@ -53,17 +72,127 @@ func (c *CheckCommand) Execute(args []string) int {
 		path = resolvedPath
 	}
 
+	// Esquema de numeração configurado (specs.numbering)
+	scheme, err := c.configSvc.NumberingScheme()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Verificações opcionais configuradas (specs.orphans, specs.title_slug)
+	optional, err := c.optionalChecks()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Links externos são verificados apenas sob demanda (acessam a rede)
+	var external *linkcheckerSvc.Options
+	if opts.External != nil {
+		external = opts.External
+		if !opts.NoCache {
+			// Cache fora do diretório de specs, para não entrar no controle de versão
+			cachePath, err := linkcheckerSvc.CachePath(path)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "aviso: links verificados sem cache: %v\n", err)
+			}
+			external.CachePath = cachePath
+		}
+	}
+
+	// Registrar problemas atuais como baseline (com --external, inclusive os de links externos)
+	if opts.UpdateBaseline {
+		return c.updateBaseline(path, scheme, optional, external)
+	}
+
+	// Corrigir numeração e nomes antes de verificar (ou apenas exibir as correções com --dry-run)
+	if opts.Fix {
+		fixResult, err := c.checkerSvc.Fix(checkerSvc.FixOptions{
+			Path:      path,
+			DryRun:    opts.DryRun,
+			Numbering: scheme,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 2
+		}
+		c.printFixResult(fixResult)
+		if opts.DryRun {
+			return 0
+		}
+		fmt.Println()
+	}
+
+	// Restringir a specs alteradas desde a revisão informada
+	var files []string
+	if opts.ChangedSince != "" {
+		files, err = changedSpecFiles(c.fs, c.git, path, opts.ChangedSince)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 2
+		}
+		if len(files) == 0 && !opts.Watch {
+			fmt.Printf("Nenhuma spec alterada desde %s\n", opts.ChangedSince)
+			return 0
+		}
+	}
+
+	// Carregar baseline de problemas pré-existentes
+	var baseline *baselineSvc.Baseline
+	if !opts.NoBaseline {
+		baseline, err = c.baselineSvc.Find(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+	}
+
 	// Executar verificação
 	result, err := c.checkerSvc.Check(checkerSvc.CheckOptions{
-		Path: path,
+		Path:      path,
+		Files:     files,
+		Baseline:  baseline,
+		External:  external,
+		Numbering: scheme,
+		Orphans:   optional.Orphans,
+		TitleSlug: optional.TitleSlug,
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
 		return 2
 	}
 
+	// Modo watch: consistência estrutural envolve várias specs (numeração, links),
+	// então a verificação é refeita por completo a cada alteração
+	if opts.Watch {
+		return runWatch(c.fs, path, func(changed []string) {
+			if len(changed) > 0 {
+				updated, err := c.checkerSvc.Check(checkerSvc.CheckOptions{
+					Path:      path,
+					Files:     files,
+					Baseline:  baseline,
+					External:  external,
+					Numbering: scheme,
+					Orphans:   optional.Orphans,
+					TitleSlug: optional.TitleSlug,
+				})
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+					return
+				}
+				result = updated
+			}
+			if err := c.printOutput(result, opts, tmpl); err != nil {
+				fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			}
+		})
+	}
+
 	// Exibir resultados
-	c.printResults(result, opts)
+	if err := c.printOutput(result, opts, tmpl); err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
 
 	// Determinar código de saída
 	if len(result.Problems) > 0 {
@@ -74,20 +203,119 @@ func (c *CheckCommand) Execute(args []string) int {
 
 // checkOptions contém opções do comando check
 type checkOptions struct {
-	Path string
-	Help bool
+	Path           string
+	Watch          bool
+	ChangedSince   string
+	UpdateBaseline bool
+	NoBaseline     bool
+	External       *linkcheckerSvc.Options // nil = não verificar links externos
+	NoCache        bool
+	Fix            bool
+	DryRun         bool
+	Template       templateFlags
+	Help           bool
 }
 
 // parseArgs parseia argumentos e flags
 func (c *CheckCommand) parseArgs(args []string) (*checkOptions, error) {
 	opts := &checkOptions{}
+	external := linkcheckerSvc.Options{}
+	externalFlag := false
 
-	for _, arg := range args {
-		switch arg {
-		case "--help", "-h":
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if ok, err := parseTemplateFlag(args, &i, &opts.Template); ok {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		switch {
+		case arg == "--external":
+			externalFlag = true
+		case arg == "--offline":
+			externalFlag = true
+			external.Offline = true
+		case arg == "--no-cache":
+			opts.NoCache = true
+		case isFlag(arg, "--timeout"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			timeout, err := time.ParseDuration(value)
+			if err != nil || timeout <= 0 {
+				return nil, fmt.Errorf("timeout inválido: %s (ex.: 5s, 500ms)", value)
+			}
+			external.Timeout = timeout
+		case isFlag(arg, "--concurrency"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("concorrência inválida: %s (use um inteiro positivo)", value)
+			}
+			external.Concurrency = n
+		case isFlag(arg, "--retries"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("número de tentativas inválido: %s", value)
+			}
+			if n == 0 {
+				n = -1 // Options trata 0 como padrão; negativo desativa novas tentativas
+			}
+			external.Retries = n
+		case isFlag(arg, "--rate"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			rate, err := strconv.ParseFloat(value, 64)
+			if err != nil || rate < 0 {
+				return nil, fmt.Errorf("taxa inválida: %s (requisições por segundo por host; 0 = sem limite)", value)
+			}
+			if rate == 0 {
+				rate = -1 // Options trata 0 como padrão; negativo desativa o limite
+			}
+			external.HostRate = rate
+		case isFlag(arg, "--allow"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			external.Allow = append(external.Allow, splitPatterns(value)...)
+		case isFlag(arg, "--deny"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			external.Deny = append(external.Deny, splitPatterns(value)...)
+		case arg == "--help" || arg == "-h":
 			opts.Help = true
 			return opts, nil
-		case "--json":
+		case arg == "--watch":
+			opts.Watch = true
+		case arg == "--update-baseline":
+			opts.UpdateBaseline = true
+		case arg == "--no-baseline":
+			opts.NoBaseline = true
+		case arg == "--fix":
+			opts.Fix = true
+		case arg == "--dry-run":
+			opts.DryRun = true
+		case isFlag(arg, "--changed-since"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			opts.ChangedSince = value
+		case arg == "--json":
 			// Flag para futuro (v2)
 			// Por enquanto ignorar
 		default:
@@ -101,9 +329,136 @@ func (c *CheckCommand) parseArgs(args []string) (*checkOptions, error) {
 		}
 	}
 
+	if opts.UpdateBaseline && (opts.Watch || opts.ChangedSince != "") {
+		return nil, fmt.Errorf("--update-baseline não pode ser combinado com --watch ou --changed-since")
+	}
+
+	if opts.DryRun && !opts.Fix {
+		return nil, fmt.Errorf("--dry-run requer --fix")
+	}
+	if opts.Fix && (opts.Watch || opts.UpdateBaseline || opts.ChangedSince != "") {
+		return nil, fmt.Errorf("--fix não pode ser combinado com --watch, --update-baseline ou --changed-since")
+	}
+	if opts.Template.set() && (opts.Fix || opts.UpdateBaseline) {
+		return nil, fmt.Errorf("--template e --template-file não podem ser combinados com --fix ou --update-baseline")
+	}
+
+	if externalFlag {
+		opts.External = &external
+	} else if external.Timeout != 0 || external.Concurrency != 0 || external.Retries != 0 || external.HostRate != 0 ||
+		len(external.Allow) > 0 || len(external.Deny) > 0 || opts.NoCache {
+		return nil, fmt.Errorf("flags de links externos requerem --external")
+	}
+
 	return opts, nil
 }
 
+// splitPatterns separa uma lista de padrões por vírgula
+func splitPatterns(value string) []string {
+	var patterns []string
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// updateBaseline registra problemas atuais de check e validate no arquivo de baseline. Com
+// external, problemas de links externos também são registrados.
+func (c *CheckCommand) updateBaseline(path string, scheme numberingSvc.Scheme, optional checkerSvc.CheckOptions, external *linkcheckerSvc.Options) int {
+	checkResult, err := c.checkerSvc.Check(checkerSvc.CheckOptions{
+		Path:      path,
+		External:  external,
+		Numbering: scheme,
+		Orphans:   optional.Orphans,
+		TitleSlug: optional.TitleSlug,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	validateResult, err := c.validatorSvc.Validate(validatorSvc.ValidateOptions{
+		Path: path,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	var entries []baselineSvc.Entry
+	for _, p := range checkResult.Problems {
+		entries = append(entries, checkerSvc.BaselineEntry(p, path, path))
+	}
+	for _, vr := range validateResult.Results {
+		entries = append(entries, validatorSvc.BaselineEntries(vr, path)...)
+	}
+
+	if _, err := c.baselineSvc.Save(path, entries); err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	relPath, _ := filepath.Rel(".", c.baselineSvc.Path(path))
+	if relPath == "" {
+		relPath = c.baselineSvc.Path(path)
+	}
+	fmt.Printf("Baseline atualizado em %s\n", relPath)
+	fmt.Printf("  Problemas de consistência: %d\n", len(checkResult.Problems))
+	fmt.Printf("  Erros de validação: %d\n", len(entries)-len(checkResult.Problems))
+	printExternalSummary(checkResult.External)
+	fmt.Println()
+	fmt.Println("Faça commit do arquivo para que check/validate reportem apenas problemas novos.")
+	return 0
+}
+
+// optionalChecks retorna as verificações opcionais configuradas: detecção de specs não referenciadas
+// (specs.orphans) e comparação entre título e nome do arquivo (specs.title_slug)
+func (c *CheckCommand) optionalChecks() (checkerSvc.CheckOptions, error) {
+	var optional checkerSvc.CheckOptions
+	cfg, err := c.configSvc.Load()
+	if err != nil {
+		return optional, err
+	}
+
+	orphans, err := configSeverity("specs.orphans", cfg.Specs.Orphans)
+	if err != nil {
+		return optional, err
+	}
+	if orphans != "" {
+		optional.Orphans = &checkerSvc.OrphanOptions{Severity: orphans, Roots: cfg.Specs.OrphanRoots}
+	}
+
+	optional.TitleSlug, err = configSeverity("specs.title_slug", cfg.Specs.TitleSlug)
+	return optional, err
+}
+
+// configSeverity interpreta a severidade de uma verificação opcional (vazio = desativada)
+func configSeverity(key string, value string) (string, error) {
+	switch value {
+	case "", "off":
+		return "", nil
+	case "warning", "error":
+		return value, nil
+	default:
+		return "", fmt.Errorf("%s inválido: %s (use off, warning ou error)", key, value)
+	}
+}
+
+// printOutput exibe os resultados ou, se informado, aplica o template a cada problema
+func (c *CheckCommand) printOutput(result *checkerSvc.CheckResult, opts *checkOptions, tmpl *reportTemplate) error {
+	if tmpl == nil {
+		c.printResults(result, opts)
+		return nil
+	}
+	items := make([]interface{}, len(result.Problems))
+	for i, problem := range result.Problems {
+		items[i] = problem
+	}
+	return tmpl.render(os.Stdout, result, items)
+}
+
 // printResults exibe resultados da verificação
 func (c *CheckCommand) printResults(result *checkerSvc.CheckResult, opts *checkOptions) {
 	path := opts.Path
@@ -121,8 +476,15 @@ func (c *CheckCommand) printResults(result *checkerSvc.CheckResult, opts *checkO
 		fmt.Println("✅ Numeração: OK")
 		fmt.Println("✅ Links: Todos os links válidos")
 		fmt.Println("✅ Estrutura: OK")
+		if result.External != nil {
+			fmt.Println("✅ Links externos: OK")
+		}
 		fmt.Println()
 		fmt.Println("Todas as verificações passaram!")
+		printExternalSummary(result.External)
+		if result.Suppressed > 0 {
+			fmt.Printf("(%d problema(s) pré-existente(s) suprimido(s) pelo baseline)\n", result.Suppressed)
+		}
 		return
 	}
 
@@ -132,12 +494,19 @@ func (c *CheckCommand) printResults(result *checkerSvc.CheckResult, opts *checkO
 		problemsByCategory[p.Category] = append(problemsByCategory[p.Category], p)
 	}
 
-	// Exibir problemas por categoria
-	categories := []string{"Numeração", "Links", "Formato", "Órfãs"}
+	// Exibir problemas por categoria (categorias opcionais só aparecem quando há problemas)
+	categories := []string{"Numeração", "Links", "Links externos", "Formato", "Títulos", "Órfãs", "Dependências", "Supressões"}
+	optional := map[string]bool{"Dependências": true, "Supressões": true}
 	for _, category := range categories {
 		problems := problemsByCategory[category]
 		if len(problems) == 0 {
-			fmt.Printf("✅ %s: OK\n", category)
+			if category == "Links externos" {
+				if result.External != nil {
+					fmt.Printf("✅ %s: OK\n", category)
+				}
+			} else if !optional[category] {
+				fmt.Printf("✅ %s: OK\n", category)
+			}
 			continue
 		}
 
@@ -196,11 +565,78 @@ func (c *CheckCommand) printResults(result *checkerSvc.CheckResult, opts *checkO
 	fmt.Println("Resumo:")
 	fmt.Printf("  Total de specs: %d\n", result.TotalSpecs)
 	fmt.Printf("  Problemas encontrados: %d\n", len(result.Problems))
-	for category, count := range result.Summary {
-		fmt.Printf("  - %s: %d\n", category, count)
+	for _, category := range categories {
+		if count := result.Summary[category]; count > 0 {
+			fmt.Printf("  - %s: %d\n", category, count)
+		}
+	}
+	if result.Suppressed > 0 {
+		fmt.Printf("  Suprimidos pelo baseline: %d\n", result.Suppressed)
+	}
+	printExternalSummary(result.External)
+}
+
+// printFixResult exibe as correções de `check --fix`
+func (c *CheckCommand) printFixResult(result *checkerSvc.FixResult) {
+	if result.Empty() {
+		fmt.Println("Nenhuma correção necessária.")
+		return
+	}
+
+	if result.Applied {
+		fmt.Println("Correções aplicadas:")
+	} else {
+		fmt.Println("Correções planejadas (nenhum arquivo foi alterado):")
+	}
+	fmt.Println()
+	printRenameChanges(result)
+	fmt.Printf("%d spec(s) renomeada(s), %d arquivo(s) com referências atualizadas\n", len(result.Renames), len(result.Edits))
+}
+
+// printRenameChanges exibe renomeações e linhas alteradas em formato de diff (check --fix e mv)
+func printRenameChanges(result *checkerSvc.FixResult) {
+	if len(result.Renames) > 0 {
+		fmt.Println("Renomeações:")
+		for _, r := range result.Renames {
+			if r.Reason != "" {
+				fmt.Printf("  %s → %s (%s)\n", r.From, r.To, r.Reason)
+			} else {
+				fmt.Printf("  %s → %s\n", r.From, r.To)
+			}
+		}
+		fmt.Println()
+	}
+
+	for _, edit := range result.Edits {
+		fmt.Printf("--- a/%s\n", filepath.ToSlash(edit.File))
+		fmt.Printf("+++ b/%s\n", filepath.ToSlash(edit.To))
+		for _, change := range edit.Changes {
+			fmt.Printf("@@ -%d +%d @@\n", change.Line, change.Line)
+			fmt.Printf("-%s\n", change.Before)
+			fmt.Printf("+%s\n", strings.ReplaceAll(change.After, "\n", "\n+"))
+		}
+	}
+	if len(result.Edits) > 0 {
+		fmt.Println()
+	}
+
+	for _, kept := range result.Kept {
+		fmt.Printf("Mantida (supressão de numbering/format): %s\n", kept)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(os.Stderr, "aviso: %s\n", warning)
 	}
 }
 
+// printExternalSummary exibe quantas URLs externas foram verificadas, reaproveitadas do cache ou ignoradas
+func printExternalSummary(summary *checkerSvc.ExternalSummary) {
+	if summary == nil {
+		return
+	}
+	fmt.Printf("  Links externos: %d URL(s) (%d verificada(s), %d em cache, %d ignorada(s))\n",
+		summary.URLs, summary.Checked, summary.Cached, summary.Skipped)
+}
+
 func (c *CheckCommand) printHelp() {
 	fmt.Println("Verifica consistência estrutural de specs (numeração, links, referências).")
 	fmt.Println()
@@ -208,9 +644,35 @@ func (c *CheckCommand) printHelp() {
 	fmt.Println("  specs check [caminho] [flags]")
 	fmt.Println()
 	fmt.Println("Flags:")
-	fmt.Println("  --help    Exibe ajuda para este comando")
+	fmt.Println("  --watch                  Observa alterações e verifica novamente")
+	fmt.Println("  --changed-since <rev>    Reporta apenas problemas de specs alteradas desde a revisão git")
+	fmt.Println("  --update-baseline        Registra os problemas atuais (check e validate) no baseline")
+	fmt.Println("  --no-baseline            Ignora o baseline e reporta todos os problemas")
+	fmt.Println("  --fix                    Corrige numeração e nomes de arquivos e atualiza referências")
+	fmt.Println("  --dry-run                Com --fix, apenas exibe as correções (diff), sem alterar arquivos")
+	fmt.Println("  --external               Verifica também links http/https (acessa a rede)")
+	fmt.Println("  --offline                Verifica links externos apenas pelo cache, sem acessar a rede")
+	fmt.Println("  --timeout <duração>      Timeout por requisição (padrão: 10s)")
+	fmt.Println("  --concurrency <n>        Verificações simultâneas (padrão: 8)")
+	fmt.Println("  --retries <n>            Novas tentativas após timeout, erro de rede, 429 ou 5xx (padrão: 2)")
+	fmt.Println("  --rate <n>               Requisições por segundo por host (padrão: 2; 0 = sem limite)")
+	fmt.Println("  --allow <padrões>        Verifica apenas URLs que casam com os padrões (separados por vírgula)")
+	fmt.Println("  --deny <padrões>         Nunca verifica URLs que casam com os padrões (separados por vírgula)")
+	fmt.Println("  --no-cache               Não lê nem grava o cache de links externos")
+	fmt.Println("  --template <template>    Formata cada problema com um template Go (campos de Problem)")
+	fmt.Println("  --template-file <arq>    Lê o template de um arquivo")
+	fmt.Println("  --help                   Exibe ajuda para este comando")
 	fmt.Println()
 	fmt.Println("Exemplos:")
 	fmt.Println("  specs check                    # Verifica specs/ no diretório atual")
+	fmt.Println("  specs check --watch            # Verifica a cada alteração salva")
+	fmt.Println("  specs check --changed-since origin/main  # Apenas specs alteradas no branch")
+	fmt.Println("  specs check --update-baseline  # Aceita problemas atuais e reporta apenas novos")
+	fmt.Println("  specs check --fix --dry-run    # Mostra renomeações e links que seriam corrigidos")
+	fmt.Println("  specs check --external --deny localhost,*.internal  # Verifica links externos")
 	fmt.Println("  specs check specs/             # Verifica diretório specs/")
+	fmt.Println("  specs check --template '{{.File}}:{{.Line}}: {{.Message}}'  # Formato de compilador")
+	fmt.Println()
+	fmt.Println("Specs não referenciadas são detectadas com 'specs config set specs.orphans warning' (ou error).")
+	fmt.Println("Nomes de arquivo que não correspondem ao título, com 'specs config set specs.title_slug warning'.")
 }
@@ -203,4 +203,10 @@ func (c *ConfigCommand) printHelp() {
 	fmt.Println("Chaves disponíveis:")
 	fmt.Println("  specs.default_path       Caminho padrão para diretório de specs (string)")
 	fmt.Println("  specs.exclude_templates  Excluir specs de template do dashboard (boolean)")
+	fmt.Println("  specs.coverage_pattern   Padrão (regex) das anotações lidas por specs coverage (string)")
+	fmt.Println("  specs.numbering          Modelo de numeração das specs: 00, 000, ADR-0000, 00.0 (string)")
+	fmt.Println("  specs.numbering_namespaces  Diretórios com numeração própria, separados por vírgula; - remove (string)")
+	fmt.Println("  specs.orphans            Detecção de specs não referenciadas: off, warning ou error (string)")
+	fmt.Println("  specs.orphan_roots       Specs raiz da detecção de órfãs, separadas por vírgula (padrão: 00-*)")
+	fmt.Println("  specs.title_slug         Nome do arquivo diferente do título: off, warning ou error (string)")
 }
@@ -0,0 +1,345 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dreibox/specs/internal/adapters"
+	configSvc "github.com/dreibox/specs/internal/services/config"
+	coverageSvc "github.com/dreibox/specs/internal/services/coverage"
+	numberingSvc "github.com/dreibox/specs/internal/services/numbering"
+)
+
+// CoverageCommand implementa o comando coverage
+type CoverageCommand struct {
+	fs          adapters.FileSystem
+	coverageSvc *coverageSvc.Service
+	configSvc   *configSvc.Service
+}
+
+// NewCoverageCommand cria uma nova instância do CoverageCommand
+func NewCoverageCommand(fs adapters.FileSystem) *CoverageCommand {
+	return &CoverageCommand{
+		fs:          fs,
+		coverageSvc: coverageSvc.NewService(fs),
+		configSvc:   configSvc.NewService(fs),
+	}
+}
+
+// Execute executa o comando coverage
+func (c *CoverageCommand) Execute(args []string) int {
+	// Parsear flags e argumentos
+	opts, err := c.parseArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Verificar flag --help
+	if opts.Help {
+		c.printHelp()
+		return 0
+	}
+
+	// Resolver caminho padrão se não fornecido
+	path := opts.Path
+	if path == "" {
+		resolvedPath, err := c.configSvc.ResolveDefaultPath()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+		path = resolvedPath
+	}
+
+	// Esquema de numeração configurado (specs.numbering)
+	scheme, err := c.configSvc.NumberingScheme()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Modo de testes: cruza critérios de aceite com a saída de `go test -json`
+	if opts.Tests != "" {
+		return c.executeTests(path, scheme, opts)
+	}
+
+	// Padrão de anotação: flag > configuração > padrão
+	pattern := opts.Pattern
+	if pattern == "" {
+		if cfg, err := c.configSvc.Load(); err == nil {
+			pattern = cfg.Specs.CoveragePattern
+		}
+	}
+
+	// Calcular cobertura
+	result, err := c.coverageSvc.Coverage(coverageSvc.CoverageOptions{
+		SpecsPath:  path,
+		SourcePath: opts.Source,
+		Pattern:    pattern,
+		Extensions: opts.Extensions,
+		Numbering:  scheme,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Exibir resultados
+	c.printResults(result, opts)
+
+	// Anotações inválidas ou cobertura abaixo do mínimo falham o comando
+	if len(result.Invalid) > 0 || (opts.Min > 0 && result.Percent < opts.Min) {
+		return 1
+	}
+	return 0
+}
+
+// coverageOptions contém opções do comando coverage
+type coverageOptions struct {
+	Path       string
+	Source     string
+	Pattern    string
+	Extensions []string
+	Tests      string // Relatório de `go test -json` ("-" = stdin)
+	Min        int
+	Help       bool
+}
+
+// parseArgs parseia argumentos e flags
+func (c *CoverageCommand) parseArgs(args []string) (*coverageOptions, error) {
+	opts := &coverageOptions{Source: "."}
+	var extStr, minStr string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		var err error
+		switch {
+		case arg == "--help" || arg == "-h":
+			opts.Help = true
+			return opts, nil
+		case isFlag(arg, "--source"):
+			opts.Source, err = flagValue(args, &i)
+		case isFlag(arg, "--pattern"):
+			opts.Pattern, err = flagValue(args, &i)
+		case isFlag(arg, "--ext"):
+			extStr, err = flagValue(args, &i)
+		case isFlag(arg, "--tests"):
+			opts.Tests, err = flagValue(args, &i)
+		case isFlag(arg, "--min"):
+			minStr, err = flagValue(args, &i)
+		case strings.HasPrefix(arg, "-"):
+			return nil, fmt.Errorf("flag desconhecida: %s", arg)
+		default:
+			if opts.Path == "" {
+				opts.Path = arg
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if extStr != "" {
+		for _, ext := range strings.Split(extStr, ",") {
+			ext = strings.TrimSpace(ext)
+			if ext == "" {
+				continue
+			}
+			if !strings.HasPrefix(ext, ".") {
+				ext = "." + ext
+			}
+			opts.Extensions = append(opts.Extensions, ext)
+		}
+	}
+	if minStr != "" {
+		min, err := strconv.Atoi(strings.TrimSuffix(minStr, "%"))
+		if err != nil || min < 0 || min > 100 {
+			return nil, fmt.Errorf("valor inválido para --min: %s (esperado 0 a 100)", minStr)
+		}
+		opts.Min = min
+	}
+
+	return opts, nil
+}
+
+// printResults exibe a cobertura por spec, anotações inválidas e resumo
+func (c *CoverageCommand) printResults(result *coverageSvc.CoverageResult, opts *coverageOptions) {
+	fmt.Printf("Cobertura de implementação (anotações em %s)\n\n", opts.Source)
+
+	if len(result.Specs) == 0 {
+		fmt.Println("Nenhum requisito funcional (RFNN) encontrado.")
+	}
+
+	for _, spec := range result.Specs {
+		fmt.Printf("%s  %d/%d (%d%%)\n", spec.File, spec.Implemented, len(spec.Requirements), spec.Percent)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, req := range spec.Requirements {
+			if !req.Implemented() {
+				fmt.Fprintf(w, "  %s\t❌ %s\t-\n", req.ID, req.Title)
+				continue
+			}
+			locations := make([]string, len(req.Annotations))
+			for i, a := range req.Annotations {
+				locations[i] = fmt.Sprintf("%s:%d", a.File, a.Line)
+			}
+			fmt.Fprintf(w, "  %s\t✅ %s\t%s\n", req.ID, req.Title, strings.Join(locations, ", "))
+		}
+		w.Flush()
+		fmt.Println()
+	}
+
+	if len(result.Invalid) > 0 {
+		fmt.Printf("❌ Anotações inválidas: %d\n", len(result.Invalid))
+		for _, a := range result.Invalid {
+			fmt.Printf("  - %s:%d: %s (%s)\n", a.File, a.Line, a.Reason, a.Requirement)
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("Resumo:")
+	fmt.Printf("  Requisitos: %d\n", result.TotalRequirements)
+	fmt.Printf("  Implementados: %d (%d%%)\n", result.Implemented, result.Percent)
+	fmt.Printf("  Sem implementação: %d\n", result.TotalRequirements-result.Implemented)
+	fmt.Printf("  Anotações: %d (%d inválida(s))\n", result.Annotations, len(result.Invalid))
+	if opts.Min > 0 && result.Percent < opts.Min {
+		fmt.Printf("  Cobertura abaixo do mínimo: %d%% < %d%%\n", result.Percent, opts.Min)
+	}
+}
+
+// executeTests cruza os critérios de aceite das specs com um relatório de `go test -json`
+func (c *CoverageCommand) executeTests(path string, scheme numberingSvc.Scheme, opts *coverageOptions) int {
+	var report []byte
+	var err error
+	if opts.Tests == "-" {
+		report, err = io.ReadAll(os.Stdin)
+	} else {
+		report, err = c.fs.ReadFile(opts.Tests)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: falha ao ler relatório de testes %s: %v\n", opts.Tests, err)
+		return 2
+	}
+
+	result, err := c.coverageSvc.TestCoverage(coverageSvc.TestCoverageOptions{
+		SpecsPath: path,
+		Report:    bytes.NewReader(report),
+		Numbering: scheme,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	c.printTestResults(result, opts)
+
+	// Critérios falhando, referências inválidas ou verificação abaixo do mínimo falham o comando
+	if result.Failing > 0 || len(result.Invalid) > 0 || (opts.Min > 0 && result.Percent < opts.Min) {
+		return 1
+	}
+	return 0
+}
+
+// printTestResults exibe a situação dos critérios de aceite por spec, referências inválidas e resumo
+func (c *CoverageCommand) printTestResults(result *coverageSvc.TestCoverageResult, opts *coverageOptions) {
+	source := opts.Tests
+	if source == "-" {
+		source = "stdin"
+	}
+	fmt.Printf("Critérios de aceite verificados por testes (%s)\n\n", source)
+
+	if len(result.Specs) == 0 {
+		fmt.Println("Nenhum critério de aceite encontrado.")
+	}
+
+	for _, spec := range result.Specs {
+		fmt.Printf("%s  %d/%d (%d%%)\n", spec.File, spec.Verified, len(spec.Criteria), spec.Percent)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, criterion := range spec.Criteria {
+			icon := "⚪"
+			switch criterion.Status {
+			case coverageSvc.StatusVerified:
+				icon = "✅"
+			case coverageSvc.StatusFailing:
+				icon = "❌"
+			}
+			tests := "-"
+			if len(criterion.Tests) > 0 {
+				tests = strings.Join(criterion.Tests, ", ")
+			}
+			fmt.Fprintf(w, "  %s\t%s %s\t%s\n", criterion.ID, icon, criterion.Text, tests)
+		}
+		w.Flush()
+		fmt.Println()
+	}
+
+	if len(result.Invalid) > 0 {
+		fmt.Printf("❌ Referências inválidas: %d\n", len(result.Invalid))
+		for _, ref := range result.Invalid {
+			fmt.Printf("  - %s: %s (%s)\n", ref.Test, ref.Reason, ref.Criterion)
+		}
+		fmt.Println()
+	}
+
+	if len(result.Warnings) > 0 {
+		fmt.Printf("⚠️  Critérios com ID ordinal: %d\n", len(result.Warnings))
+		for _, w := range result.Warnings {
+			fmt.Printf("  - %s\n", w)
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("Resumo:")
+	fmt.Printf("  Critérios: %d\n", result.TotalCriteria)
+	fmt.Printf("  Verificados: %d (%d%%)\n", result.Verified, result.Percent)
+	fmt.Printf("  Falhando: %d\n", result.Failing)
+	fmt.Printf("  Sem teste: %d\n", result.Untested)
+	fmt.Printf("  Testes no relatório: %d\n", result.Tests)
+	if opts.Min > 0 && result.Percent < opts.Min {
+		fmt.Printf("  Verificação abaixo do mínimo: %d%% < %d%%\n", result.Percent, opts.Min)
+	}
+}
+
+func (c *CoverageCommand) printHelp() {
+	fmt.Println("Mapeia anotações no código-fonte para requisitos das specs e calcula a cobertura de implementação.")
+	fmt.Println()
+	fmt.Println("Uso:")
+	fmt.Println("  specs coverage [caminho-specs] [flags]")
+	fmt.Println()
+	fmt.Println("Flags:")
+	fmt.Println("  --source <dir>      Diretório de código-fonte a varrer (padrão: .)")
+	fmt.Println("  --pattern <regex>   Padrão das anotações, com grupos (?P<spec>...) e (?P<reqs>...)")
+	fmt.Println("  --ext <lista>       Extensões varridas, separadas por vírgula (padrão: .go)")
+	fmt.Println("  --tests <arquivo>   Cruza critérios de aceite com a saída de 'go test -json' (- para stdin)")
+	fmt.Println("  --min <percentual>  Falha se a cobertura geral ficar abaixo do percentual")
+	fmt.Println("  --help              Exibe ajuda para este comando")
+	fmt.Println()
+	// Exemplos montados com Printf para não serem reconhecidos como anotações do próprio CLI
+	fmt.Println("Anotação padrão:")
+	fmt.Printf("  // %s 03-specs-validate RF04\n", "spec:")
+	fmt.Printf("  // %s 03 RF01, RF02\n", "spec:")
+	fmt.Println()
+	fmt.Println("A spec pode ser indicada pelo nome completo, pelo nome sem numeração ou pela numeração (se única).")
+	fmt.Println("O padrão também pode ser definido com 'specs config set specs.coverage_pattern <regex>'.")
+	fmt.Println()
+	fmt.Println("Com --tests, critérios de aceite (**CA01** no início do critério ou, sem ID, CA01, CA02...")
+	fmt.Println("na ordem da seção) são referenciados")
+	fmt.Println("pelo nome do teste (TestValidate_Spec03_CA02) ou por marcador na saída do teste:")
+	fmt.Printf("  t.Log(\"%s 03-specs-validate CA02\")\n", "spec:")
+	fmt.Println()
+	fmt.Println("Exemplos:")
+	fmt.Println("  specs coverage                      # Specs em specs/, código em .")
+	fmt.Println("  specs coverage --source internal/   # Varre apenas internal/")
+	fmt.Println("  specs coverage --min 80             # Falha abaixo de 80%")
+	fmt.Println("  go test -json ./... | specs coverage --tests -")
+	fmt.Println()
+	fmt.Println("Códigos de saída:")
+	fmt.Println("  0  Sucesso")
+	fmt.Println("  1  Anotações ou referências inválidas, critérios falhando ou cobertura abaixo de --min")
+	fmt.Println("  2  Erro de input inválido")
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+)
+
+// isFlag indica se arg é a flag informada, nas formas "--flag" ou "--flag=valor"
+func isFlag(arg, name string) bool {
+	return arg == name || strings.HasPrefix(arg, name+"=")
+}
+
+// flagValue retorna o valor de uma flag ("--flag valor" ou "--flag=valor"), avançando o índice
+// quando o valor está no próximo argumento. O valor pode começar com "-" (ex.: padrões regex).
+func flagValue(args []string, i *int) (string, error) {
+	name, value, ok := strings.Cut(args[*i], "=")
+	if !ok {
+		if *i+1 >= len(args) {
+			return "", fmt.Errorf("flag %s requer um valor", name)
+		}
+		*i++
+		value = args[*i]
+	}
+	if value == "" {
+		return "", fmt.Errorf("flag %s requer um valor", name)
+	}
+	return value, nil
+}
@@ -0,0 +1,269 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/dreibox/specs/internal/adapters"
+	configSvc "github.com/dreibox/specs/internal/services/config"
+	graphSvc "github.com/dreibox/specs/internal/services/graph"
+)
+
+// Formatos de saída do grafo de dependências
+const (
+	graphFormatMermaid = "mermaid"
+	graphFormatDOT     = "dot"
+	graphFormatJSON    = "json"
+)
+
+// mermaidIDRegex casa caracteres não aceitos em IDs de nós Mermaid
+var mermaidIDRegex = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// GraphCommand implementa o comando graph
+type GraphCommand struct {
+	fs        adapters.FileSystem
+	graphSvc  *graphSvc.Service
+	configSvc *configSvc.Service
+}
+
+// NewGraphCommand cria uma nova instância do GraphCommand
+func NewGraphCommand(fs adapters.FileSystem) *GraphCommand {
+	return &GraphCommand{
+		fs:        fs,
+		graphSvc:  graphSvc.NewService(fs),
+		configSvc: configSvc.NewService(fs),
+	}
+}
+
+// Execute executa o comando graph
+func (c *GraphCommand) Execute(args []string) int {
+	// Parsear flags e argumentos
+	opts, err := c.parseArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Verificar flag --help
+	if opts.Help {
+		c.printHelp()
+		return 0
+	}
+
+	// Resolver caminho padrão se não fornecido
+	path := opts.Path
+	if path == "" {
+		resolvedPath, err := c.configSvc.ResolveDefaultPath()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+		path = resolvedPath
+	}
+
+	// Esquema de numeração configurado (specs.numbering)
+	scheme, err := c.configSvc.NumberingScheme()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Construir grafo
+	graph, err := c.graphSvc.Build(graphSvc.GraphOptions{
+		Path:      path,
+		Numbering: scheme,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Exibir grafo no formato solicitado
+	switch opts.Format {
+	case graphFormatDOT:
+		c.printDOT(graph)
+	case graphFormatJSON:
+		err = c.printJSON(graph)
+	default:
+		c.printMermaid(graph)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Problemas vão para stderr para não poluir o grafo gerado
+	c.printProblems(graph)
+
+	// Ciclos entre dependências declaradas e dependências inexistentes falham o comando
+	if len(graph.Unresolved) > 0 {
+		return 1
+	}
+	for _, cycle := range graph.Cycles {
+		if cycle.Declared {
+			return 1
+		}
+	}
+	return 0
+}
+
+// graphOptions contém opções do comando graph
+type graphOptions struct {
+	Path   string
+	Format string
+	Help   bool
+}
+
+// parseArgs parseia argumentos e flags
+func (c *GraphCommand) parseArgs(args []string) (*graphOptions, error) {
+	opts := &graphOptions{Format: graphFormatMermaid}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--help" || arg == "-h":
+			opts.Help = true
+			return opts, nil
+		case arg == "--format":
+			if i+1 >= len(args) || strings.HasPrefix(args[i+1], "-") {
+				return nil, fmt.Errorf("flag --format requer um valor (mermaid, dot ou json)")
+			}
+			i++
+			opts.Format = args[i]
+		case strings.HasPrefix(arg, "--format="):
+			opts.Format = strings.TrimPrefix(arg, "--format=")
+		case strings.HasPrefix(arg, "-"):
+			return nil, fmt.Errorf("flag desconhecida: %s", arg)
+		default:
+			if opts.Path == "" {
+				opts.Path = arg
+			}
+		}
+	}
+
+	switch opts.Format {
+	case graphFormatMermaid, graphFormatDOT, graphFormatJSON:
+	default:
+		return nil, fmt.Errorf("formato inválido: %s (use mermaid, dot ou json)", opts.Format)
+	}
+
+	return opts, nil
+}
+
+// printMermaid exibe o grafo como flowchart Mermaid (depends_on com seta contínua, links com seta tracejada)
+func (c *GraphCommand) printMermaid(graph *graphSvc.Graph) {
+	fmt.Println("graph LR")
+	for _, n := range graph.Nodes {
+		fmt.Printf("  %s[\"%s\"]\n", mermaidID(n.ID), strings.ReplaceAll(n.ID, `"`, "#quot;"))
+	}
+	for _, e := range graph.Edges {
+		arrow := "-->"
+		if e.Kind == graphSvc.EdgeLink {
+			arrow = "-.->"
+		}
+		fmt.Printf("  %s %s %s\n", mermaidID(e.From), arrow, mermaidID(e.To))
+	}
+
+	var deprecated []string
+	for _, n := range graph.Nodes {
+		if n.Deprecated {
+			deprecated = append(deprecated, mermaidID(n.ID))
+		}
+	}
+	if len(deprecated) > 0 {
+		fmt.Println("  classDef deprecated stroke-dasharray: 5 5,color:#888")
+		fmt.Printf("  class %s deprecated\n", strings.Join(deprecated, ","))
+	}
+}
+
+// printDOT exibe o grafo no formato DOT do Graphviz
+func (c *GraphCommand) printDOT(graph *graphSvc.Graph) {
+	fmt.Println("digraph specs {")
+	fmt.Println("  rankdir=LR;")
+	fmt.Println("  node [shape=box];")
+	for _, n := range graph.Nodes {
+		attrs := ""
+		if n.Deprecated {
+			attrs = ", style=dashed, fontcolor=gray"
+		}
+		fmt.Printf("  %s [label=%s%s];\n", dotQuote(n.ID), dotQuote(n.ID), attrs)
+	}
+	for _, e := range graph.Edges {
+		attrs := ""
+		if e.Kind == graphSvc.EdgeLink {
+			attrs = " [style=dashed]"
+		}
+		fmt.Printf("  %s -> %s%s;\n", dotQuote(e.From), dotQuote(e.To), attrs)
+	}
+	fmt.Println("}")
+}
+
+// printJSON exibe o grafo em JSON
+func (c *GraphCommand) printJSON(graph *graphSvc.Graph) error {
+	data, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return fmt.Errorf("falha ao gerar JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// printProblems exibe ciclos, dependências inexistentes e dependências de specs obsoletas em stderr
+func (c *GraphCommand) printProblems(graph *graphSvc.Graph) {
+	for _, cycle := range graph.Cycles {
+		icon := "⚠️ "
+		if cycle.Declared {
+			icon = "❌"
+		}
+		fmt.Fprintf(os.Stderr, "%s Ciclo de dependências: %s\n", icon, strings.Join(cycle.Specs, " → "))
+	}
+	for _, u := range graph.Unresolved {
+		fmt.Fprintf(os.Stderr, "❌ %s: dependência '%s' inválida: %s\n", u.From, u.Target, u.Reason)
+	}
+	for _, e := range graph.Deprecated {
+		fmt.Fprintf(os.Stderr, "⚠️  %s depende de spec obsoleta: %s\n", e.From, e.To)
+	}
+}
+
+// mermaidID converte o ID da spec em identificador de nó Mermaid
+func mermaidID(id string) string {
+	return "s_" + mermaidIDRegex.ReplaceAllString(id, "_")
+}
+
+// dotQuote retorna o valor entre aspas, escapando aspas internas
+func dotQuote(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
+
+func (c *GraphCommand) printHelp() {
+	fmt.Println("Gera o grafo de dependências entre specs a partir de links e da chave depends_on do frontmatter.")
+	fmt.Println()
+	fmt.Println("Uso:")
+	fmt.Println("  specs graph [caminho] [flags]")
+	fmt.Println()
+	fmt.Println("Flags:")
+	fmt.Println("  --format <formato>  Formato de saída: mermaid (padrão), dot ou json")
+	fmt.Println("  --help              Exibe ajuda para este comando")
+	fmt.Println()
+	fmt.Println("Frontmatter:")
+	fmt.Println("  ---")
+	fmt.Println("  status: deprecated          # Marca a spec como obsoleta")
+	fmt.Println("  depends_on: [03, 05-specs-check]")
+	fmt.Println("  ---")
+	fmt.Println()
+	fmt.Println("Ciclos, dependências inexistentes e dependências de specs obsoletas são exibidos em stderr.")
+	fmt.Println("Ciclos que passam por links (não apenas depends_on) são avisos.")
+	fmt.Println()
+	fmt.Println("Exemplos:")
+	fmt.Println("  specs graph                          # Mermaid para specs/")
+	fmt.Println("  specs graph --format dot | dot -Tsvg > specs.svg")
+	fmt.Println("  specs graph --format json")
+	fmt.Println()
+	fmt.Println("Códigos de saída:")
+	fmt.Println("  0  Sucesso (dependências de specs obsoletas são apenas avisos)")
+	fmt.Println("  1  Ciclo entre dependências declaradas ou dependência inexistente")
+	fmt.Println("  2  Erro de input inválido")
+}
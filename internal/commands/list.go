@@ -1,16 +1,29 @@
 package commands
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dreibox/specs/internal/adapters"
 	configSvc "github.com/dreibox/specs/internal/services/config"
 	listerSvc "github.com/dreibox/specs/internal/services/lister"
 )
 
+// Formatos de saída da listagem
+const (
+	listFormatTable    = "table"
+	listFormatJSON     = "json"
+	listFormatCSV      = "csv"
+	listFormatMarkdown = "markdown"
+)
+
 // ListCommand implementa o comando list
 type ListCommand struct {
 	fs          adapters.FileSystem
@@ -42,6 +55,13 @@ func (c *ListCommand) Execute(args []string) int {
 		return 0
 	}
 
+	// Template de saída (--template ou --template-file)
+	tmpl, err := loadReportTemplate(c.fs, opts.Template)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
 	// Resolver caminho padrão se não fornecido
 	path := opts.Path
 	if path == "" {
@@ -53,20 +73,61 @@ func (c *ListCommand) Execute(args []string) int {
 		path = resolvedPath
 	}
 
+	// Esquema de numeração configurado (specs.numbering)
+	scheme, err := c.configSvc.NumberingScheme()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
 	// Executar listagem
 	result, err := c.listerSvc.List(listerSvc.ListOptions{
 		Path:       path,
 		Complete:   opts.Complete,
 		Incomplete: opts.Incomplete,
 		Errors:     opts.Errors,
+		Numbering:  scheme,
+		Sort:       opts.Sort,
+		Reverse:    opts.Reverse,
+		Dir:        opts.Dir,
+		Tag:        opts.Tag,
+		Owner:      opts.Owner,
+		Status:     opts.Status,
+		Text:       opts.Text,
+		Query:      opts.Query,
+		GroupBy:    opts.GroupBy,
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
 		return 2
 	}
 
-	// Exibir resultados
-	c.printResults(result, opts)
+	// Exibir resultados no formato solicitado
+	if tmpl != nil {
+		items := make([]interface{}, len(result.Specs))
+		for i, spec := range result.Specs {
+			items[i] = spec
+		}
+		if err := tmpl.render(os.Stdout, result, items); err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 2
+		}
+		return 0
+	}
+	switch opts.Format {
+	case listFormatJSON:
+		err = c.printJSON(result)
+	case listFormatCSV:
+		err = writeListCSV(os.Stdout, result)
+	case listFormatMarkdown:
+		err = writeListMarkdown(os.Stdout, result, opts.GroupBy)
+	default:
+		c.printResults(result, opts)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
 
 	return 0
 }
@@ -77,36 +138,90 @@ type listOptions struct {
 	Complete   bool
 	Incomplete bool
 	Errors     bool
+	Sort       string
+	Reverse    bool
+	Dir        string
+	Tag        string
+	Owner      string
+	Status     string
+	Text       string
+	Query      string
+	Format     string
+	GroupBy    string
+	Template   templateFlags
 	Help       bool
 }
 
+// filtered indica se algum filtro além dos de status de validação foi informado
+func (o *listOptions) filtered() bool {
+	return o.Dir != "" || o.Tag != "" || o.Owner != "" || o.Status != "" || o.Text != "" || o.Query != ""
+}
+
 // parseArgs parseia argumentos e flags
 func (c *ListCommand) parseArgs(args []string) (*listOptions, error) {
-	opts := &listOptions{}
+	opts := &listOptions{Format: listFormatTable}
 
-	for _, arg := range args {
-		switch arg {
-		case "--help", "-h":
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		var err error
+		if ok, err := parseTemplateFlag(args, &i, &opts.Template); ok {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		switch {
+		case arg == "--help" || arg == "-h":
 			opts.Help = true
 			return opts, nil
-		case "--complete", "--only-complete":
+		case arg == "--complete" || arg == "--only-complete":
 			opts.Complete = true
-		case "--incomplete", "--only-incomplete":
+		case arg == "--incomplete" || arg == "--only-incomplete":
 			opts.Incomplete = true
-		case "--errors":
+		case arg == "--errors":
 			opts.Errors = true
-		case "--json":
-			// Flag para futuro (v2)
-			// Por enquanto ignorar
+		case arg == "--reverse":
+			opts.Reverse = true
+		case isFlag(arg, "--sort"):
+			opts.Sort, err = flagValue(args, &i)
+		case isFlag(arg, "--dir"):
+			opts.Dir, err = flagValue(args, &i)
+		case isFlag(arg, "--tag"):
+			opts.Tag, err = flagValue(args, &i)
+		case isFlag(arg, "--owner"):
+			opts.Owner, err = flagValue(args, &i)
+		case isFlag(arg, "--status"):
+			opts.Status, err = flagValue(args, &i)
+		case isFlag(arg, "--text"):
+			opts.Text, err = flagValue(args, &i)
+		case isFlag(arg, "--query") || isFlag(arg, "-q"):
+			opts.Query, err = flagValue(args, &i)
+		case isFlag(arg, "--group-by"):
+			opts.GroupBy, err = flagValue(args, &i)
+		case isFlag(arg, "--format"):
+			opts.Format, err = flagValue(args, &i)
+		case arg == "--json":
+			// Atalho para --format json
+			opts.Format = listFormatJSON
+		case strings.HasPrefix(arg, "-"):
+			return nil, fmt.Errorf("flag desconhecida: %s", arg)
 		default:
-			if strings.HasPrefix(arg, "-") {
-				if arg != "--json" {
-					return nil, fmt.Errorf("flag desconhecida: %s", arg)
-				}
-			} else if opts.Path == "" {
+			if opts.Path == "" {
 				opts.Path = arg
 			}
 		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch opts.Format {
+	case listFormatTable, listFormatJSON, listFormatCSV, listFormatMarkdown:
+	default:
+		return nil, fmt.Errorf("formato inválido: %s (use table, json, csv ou markdown)", opts.Format)
+	}
+	if opts.Template.set() && opts.Format != listFormatTable {
+		return nil, fmt.Errorf("--template e --template-file não podem ser combinados com --format")
 	}
 
 	return opts, nil
@@ -122,7 +237,9 @@ func (c *ListCommand) printResults(result *listerSvc.ListResult, opts *listOptio
 		}
 		
 		var msg string
-		if opts.Complete {
+		if opts.filtered() {
+			msg = "Nenhuma spec corresponde aos filtros informados"
+		} else if opts.Complete {
 			msg = "Nenhuma spec completa encontrada"
 		} else if opts.Incomplete {
 			msg = "Nenhuma spec incompleta encontrada"
@@ -163,8 +280,19 @@ func (c *ListCommand) printResults(result *listerSvc.ListResult, opts *listOptio
 		fmt.Printf("Listando specs em %s...\n\n", relPath)
 	}
 
-	// Exibir tabela
-	c.printTable(result.Specs)
+	// Exibir tabela (uma por grupo, com totais e progresso do grupo)
+	if result.Groups != nil {
+		for i, group := range result.Groups {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("%s (%d specs: %d completas, %d incompletas, %d com erros; progresso %d%%)\n",
+				groupLabel(opts.GroupBy, group.Key), group.Total, group.Complete, group.Incomplete, group.WithErrors, group.Progress)
+			c.printTable(group.Specs)
+		}
+	} else {
+		c.printTable(result.Specs)
+	}
 
 	// Exibir resumo
 	fmt.Println()
@@ -228,6 +356,153 @@ func (c *ListCommand) printTable(specs []listerSvc.SpecInfo) {
 	}
 }
 
+// printJSON exibe a listagem (specs e contadores) em JSON
+func (c *ListCommand) printJSON(result *listerSvc.ListResult) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("falha ao gerar JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// listCSVHeader contém as colunas do CSV, uma para cada campo de SpecInfo
+var listCSVHeader = []string{
+	"path", "number", "name", "status", "status_icon", "complete", "has_errors",
+	"checklist_found", "checklist_items", "checklist_marked", "checklist_valid_format",
+	"dir", "title", "lifecycle", "owner", "tags", "mod_time", "progress",
+}
+
+// writeListCSV escreve a listagem em CSV (uma linha por spec; tags separadas por ";").
+// Com agrupamento, a primeira coluna é o grupo e uma spec aparece uma vez por grupo.
+func writeListCSV(out io.Writer, result *listerSvc.ListResult) error {
+	w := csv.NewWriter(out)
+	header := listCSVHeader
+	if result.Groups != nil {
+		header = append([]string{"group"}, listCSVHeader...)
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	writeSpecs := func(prefix []string, specs []listerSvc.SpecInfo) error {
+		for _, spec := range specs {
+			record := append(prefix,
+				spec.Path,
+				spec.Number,
+				spec.Name,
+				spec.Status,
+				spec.StatusIcon,
+				strconv.FormatBool(spec.Complete),
+				strconv.FormatBool(spec.HasErrors),
+				strconv.FormatBool(spec.Checklist.Found),
+				strconv.Itoa(spec.Checklist.ItemCount),
+				strconv.Itoa(spec.Checklist.MarkedCount),
+				strconv.FormatBool(spec.Checklist.ValidFormat),
+				spec.Dir,
+				spec.Title,
+				spec.Lifecycle,
+				spec.Owner,
+				strings.Join(spec.Tags, ";"),
+				formatModTime(spec.ModTime),
+				strconv.Itoa(spec.Progress),
+			)
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if result.Groups != nil {
+		for _, group := range result.Groups {
+			if err := writeSpecs([]string{group.Key}, group.Specs); err != nil {
+				return err
+			}
+		}
+	} else if err := writeSpecs(nil, result.Specs); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// writeListMarkdown escreve a listagem como tabela Markdown, com o nome de cada spec
+// apontando para o arquivo, pronta para colar em um README ou descrição de PR. Com
+// agrupamento, cada grupo tem seu título, tabela e totais.
+func writeListMarkdown(out io.Writer, result *listerSvc.ListResult, groupBy string) error {
+	var b strings.Builder
+	if result.Groups != nil {
+		for _, group := range result.Groups {
+			fmt.Fprintf(&b, "### %s\n\n", groupLabel(groupBy, group.Key))
+			writeMarkdownTable(&b, group.Specs)
+			fmt.Fprintf(&b, "\n%d specs · %d completas · %d incompletas · %d com erros · progresso %d%%\n\n",
+				group.Total, group.Complete, group.Incomplete, group.WithErrors, group.Progress)
+		}
+	} else {
+		writeMarkdownTable(&b, result.Specs)
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "**Total:** %d specs · **Completas:** %d · **Incompletas:** %d · **Com erros:** %d\n",
+		result.Total, result.Complete, result.Incomplete, result.WithErrors)
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+// writeMarkdownTable escreve uma tabela Markdown com uma linha por spec
+func writeMarkdownTable(b *strings.Builder, specs []listerSvc.SpecInfo) {
+	b.WriteString("| Numeração | Nome | Título | Status | Progresso | Ciclo de vida | Responsável | Tags |\n")
+	b.WriteString("|---|---|---|---|---|---|---|---|\n")
+	for _, spec := range specs {
+		link := filepath.ToSlash(spec.Path)
+		if rel, err := filepath.Rel(".", spec.Path); err == nil && !strings.HasPrefix(rel, "..") {
+			link = filepath.ToSlash(rel)
+		}
+		fmt.Fprintf(b, "| %s | [%s](%s) | %s | %s | %d%% | %s | %s | %s |\n",
+			markdownCell(spec.Number),
+			markdownCell(spec.Name),
+			strings.ReplaceAll(link, " ", "%20"),
+			markdownCell(spec.Title),
+			markdownCell(spec.StatusIcon+" "+spec.Status),
+			spec.Progress,
+			markdownCell(spec.Lifecycle),
+			markdownCell(spec.Owner),
+			markdownCell(strings.Join(spec.Tags, ", ")),
+		)
+	}
+}
+
+// groupLabel retorna o nome de exibição de um grupo; a chave vazia indica a raiz (dir)
+// ou specs sem o metadado
+func groupLabel(groupBy, key string) string {
+	if key != "" {
+		return key
+	}
+	switch groupBy {
+	case listerSvc.GroupDir:
+		return "(raiz)"
+	case listerSvc.GroupTag:
+		return "(sem tag)"
+	case listerSvc.GroupStatus:
+		return "(sem status)"
+	case listerSvc.GroupOwner:
+		return "(sem responsável)"
+	}
+	return "(vazio)"
+}
+
+// markdownCell escapa o conteúdo de uma célula de tabela Markdown
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.ReplaceAll(value, "\n", " ")
+}
+
+// formatModTime formata a data de modificação em RFC 3339 (vazio se desconhecida)
+func formatModTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func (c *ListCommand) printHelp() {
 	fmt.Println("Lista todas as specs do projeto com status (completa/incompleta).")
 	fmt.Println()
@@ -238,11 +513,38 @@ func (c *ListCommand) printHelp() {
 	fmt.Println("  --complete, --only-complete     Lista apenas specs completas")
 	fmt.Println("  --incomplete, --only-incomplete  Lista apenas specs incompletas")
 	fmt.Println("  --errors                         Lista apenas specs com erros")
+	fmt.Println("  --sort <critério>                Ordena por number (padrão), name, mtime, progress ou status")
+	fmt.Println("  --reverse                        Inverte a ordenação")
+	fmt.Println("  --dir <diretório>                Lista apenas specs do subdiretório (relativo ao caminho)")
+	fmt.Println("  --tag <tag>                      Lista apenas specs com a tag")
+	fmt.Println("  --owner <responsável>            Lista apenas specs do responsável")
+	fmt.Println("  --status <situação>              Lista apenas specs na situação de ciclo de vida (status do frontmatter)")
+	fmt.Println("  --text <texto>                   Lista apenas specs que contenham o texto (nome, título, metadados ou conteúdo)")
+	fmt.Println("  --query, -q <expressão>          Filtra por expressão de consulta (ver abaixo)")
+	fmt.Println("  --group-by <critério>            Agrupa por dir, tag, status ou owner, com totais e progresso por grupo")
+	fmt.Println("  --format <formato>               Formato de saída: table (padrão), json, csv ou markdown")
+	fmt.Println("  --json                           Atalho para --format json")
+	fmt.Println("  --template <template>            Formata cada spec com um template Go (campos de SpecInfo)")
+	fmt.Println("  --template-file <arquivo>        Lê o template de um arquivo")
 	fmt.Println("  --help                           Exibe ajuda para este comando")
 	fmt.Println()
+	fmt.Println("Expressões de consulta:")
+	fmt.Println("  Condições campo operador valor, combinadas com and, or, not e parênteses.")
+	fmt.Println("  Campos: number, name, title, dir, tag, owner, status, validation (completa|incompleta|erro),")
+	fmt.Println("          progress (0 a 100) e text")
+	fmt.Println("  Operadores: = != ~ (contém) !~ < <= > >=")
+	fmt.Println()
 	fmt.Println("Exemplos:")
 	fmt.Println("  specs list                       # Lista todas as specs em specs/")
 	fmt.Println("  specs list --complete            # Lista apenas specs completas")
 	fmt.Println("  specs list --incomplete          # Lista apenas specs incompletas")
 	fmt.Println("  specs list specs/                # Lista specs em diretório específico")
+	fmt.Println("  specs list --sort mtime --reverse  # Specs modificadas mais recentemente primeiro")
+	fmt.Println("  specs list --tag cli --owner ana # Specs com a tag cli sob responsabilidade de ana")
+	fmt.Println("  specs list -q 'status=review and owner=ana'")
+	fmt.Println("  specs list -q 'progress<50 or validation=erro'")
+	fmt.Println("  specs list --group-by dir        # Uma tabela por subdiretório")
+	fmt.Println("  specs list --format csv > specs.csv")
+	fmt.Println("  specs list --format markdown     # Tabela para colar em README ou descrição de PR")
+	fmt.Println("  specs list --template '{{.Number}} {{.Name}} {{.Status}}'")
 }
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreibox/specs/internal/adapters"
+	listerSvc "github.com/dreibox/specs/internal/services/lister"
+	"github.com/dreibox/specs/internal/services/validator"
+)
+
+func TestListCommand_parseArgs_Format(t *testing.T) {
+	cmd := NewListCommand(adapters.NewFileSystem())
+
+	tests := []struct {
+		args     []string
+		expected string
+		wantErr  bool
+	}{
+		{nil, listFormatTable, false},
+		{[]string{"--format", "json"}, listFormatJSON, false},
+		{[]string{"--format=csv"}, listFormatCSV, false},
+		{[]string{"--format", "markdown", "specs/"}, listFormatMarkdown, false},
+		{[]string{"--json"}, listFormatJSON, false},
+		{[]string{"--format", "xml"}, "", true},
+		{[]string{"--format"}, "", true},
+	}
+
+	for _, tt := range tests {
+		opts, err := cmd.parseArgs(tt.args)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseArgs(%v) deveria retornar erro", tt.args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseArgs(%v) erro inesperado: %v", tt.args, err)
+			continue
+		}
+		if opts.Format != tt.expected {
+			t.Errorf("parseArgs(%v) Format = %q, esperado %q", tt.args, opts.Format, tt.expected)
+		}
+	}
+}
+
+func TestWriteListCSVAndMarkdown(t *testing.T) {
+	result := &listerSvc.ListResult{
+		Specs: []listerSvc.SpecInfo{
+			{
+				Path:       "specs/api/03-auth.spec.md",
+				Number:     "03",
+				Name:       "auth",
+				Status:     "Incompleta (4/6)",
+				StatusIcon: "⚠️",
+				Checklist:  validator.ChecklistInfo{Found: true, ItemCount: 6, MarkedCount: 4, ValidFormat: true},
+				Dir:        "api",
+				Title:      "Autenticação | Login",
+				Lifecycle:  "draft",
+				Owner:      "ana",
+				Tags:       []string{"api", "segurança"},
+				ModTime:    time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+				Progress:   66,
+			},
+		},
+		Total:      1,
+		Incomplete: 1,
+	}
+
+	var csvOut strings.Builder
+	if err := writeListCSV(&csvOut, result); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(csvOut.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV inválido: %v", err)
+	}
+	if len(records) != 2 || len(records[1]) != len(listCSVHeader) {
+		t.Fatalf("CSV inesperado:\n%s", csvOut.String())
+	}
+	expected := "specs/api/03-auth.spec.md,03,auth,Incompleta (4/6),⚠️,false,false,true,6,4,true,api,Autenticação | Login,draft,ana,api;segurança,2026-03-01T12:00:00Z,66"
+	if got := strings.Join(records[1], ","); got != expected {
+		t.Errorf("linha CSV:\n  obtido:   %s\n  esperado: %s", got, expected)
+	}
+
+	var mdOut strings.Builder
+	if err := writeListMarkdown(&mdOut, result, ""); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	for _, want := range []string{
+		"| Numeração | Nome | Título | Status | Progresso | Ciclo de vida | Responsável | Tags |",
+		"| 03 | [auth](specs/api/03-auth.spec.md) | Autenticação \\| Login | ⚠️ Incompleta (4/6) | 66% | draft | ana | api, segurança |",
+		"**Total:** 1 specs · **Completas:** 0 · **Incompletas:** 1 · **Com erros:** 0",
+	} {
+		if !strings.Contains(mdOut.String(), want) {
+			t.Errorf("Markdown deveria conter %q:\n%s", want, mdOut.String())
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dreibox/specs/internal/adapters"
+	checkerSvc "github.com/dreibox/specs/internal/services/checker"
+	configSvc "github.com/dreibox/specs/internal/services/config"
+)
+
+// MvCommand implementa o comando mv (alias: rename)
+type MvCommand struct {
+	fs         adapters.FileSystem
+	checkerSvc *checkerSvc.Service
+	configSvc  *configSvc.Service
+}
+
+// NewMvCommand cria uma nova instância do MvCommand
+func NewMvCommand(fs adapters.FileSystem) *MvCommand {
+	return &MvCommand{
+		fs:         fs,
+		checkerSvc: checkerSvc.NewService(fs),
+		configSvc:  configSvc.NewService(fs),
+	}
+}
+
+// Execute executa o comando mv
+func (c *MvCommand) Execute(args []string) int {
+	// Parsear flags e argumentos
+	opts, err := c.parseArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Verificar flag --help
+	if opts.Help {
+		c.printHelp()
+		return 0
+	}
+
+	// Resolver caminho padrão se não fornecido
+	path := opts.Path
+	if path == "" {
+		resolvedPath, err := c.configSvc.ResolveDefaultPath()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+		path = resolvedPath
+	}
+
+	// Esquema de numeração configurado (specs.numbering)
+	scheme, err := c.configSvc.NumberingScheme()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Renomear e atualizar referências
+	result, err := c.checkerSvc.Move(checkerSvc.MoveOptions{
+		Path:   path,
+		Spec:   opts.Spec,
+		Target: opts.Target,
+		Alias:  opts.Alias,
+		DryRun: opts.DryRun,
+
+		Numbering: scheme,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	if !result.Applied {
+		fmt.Println("Alterações planejadas (nenhum arquivo foi alterado):")
+		fmt.Println()
+	}
+	printRenameChanges(result)
+	fmt.Printf("%d arquivo(s) com referências atualizadas\n", len(result.Edits))
+	return 0
+}
+
+// mvOptions contém opções do comando mv
+type mvOptions struct {
+	Spec   string
+	Target string
+	Path   string
+	Alias  bool
+	DryRun bool
+	Help   bool
+}
+
+// parseArgs parseia argumentos e flags
+func (c *MvCommand) parseArgs(args []string) (*mvOptions, error) {
+	opts := &mvOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--help" || arg == "-h":
+			opts.Help = true
+			return opts, nil
+		case arg == "--alias":
+			opts.Alias = true
+		case arg == "--dry-run":
+			opts.DryRun = true
+		case isFlag(arg, "--path"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			opts.Path = value
+		default:
+			if strings.HasPrefix(arg, "-") {
+				return nil, fmt.Errorf("flag desconhecida: %s", arg)
+			}
+			positional = append(positional, arg)
+		}
+	}
+
+	if len(positional) != 2 {
+		return nil, fmt.Errorf("uso: specs mv <spec> <destino> (veja specs mv --help)")
+	}
+	opts.Spec, opts.Target = positional[0], positional[1]
+	return opts, nil
+}
+
+func (c *MvCommand) printHelp() {
+	fmt.Println("Renomeia, renumera ou move uma spec e atualiza todos os links para ela.")
+	fmt.Println()
+	fmt.Println("Uso:")
+	fmt.Println("  specs mv <spec> <destino> [flags]")
+	fmt.Println("  specs rename <spec> <destino> [flags]")
+	fmt.Println()
+	fmt.Println("Argumentos:")
+	fmt.Println("  <spec>      Arquivo, nome completo (03-specs-validate), nome sem numeração ou numeração")
+	fmt.Println("  <destino>   Nova numeração (07), novo nome (validacao), ambos (07-validacao) ou diretório (api/07-validacao)")
+	fmt.Println()
+	fmt.Println("Flags:")
+	fmt.Println("  --path <caminho>   Diretório de specs (padrão: caminho configurado ou ./specs)")
+	fmt.Println("  --alias            Registra o nome anterior em aliases no frontmatter da spec")
+	fmt.Println("  --dry-run          Apenas exibe as alterações (diff), sem alterar arquivos")
+	fmt.Println("  --help             Exibe ajuda para este comando")
+	fmt.Println()
+	fmt.Println("Exemplos:")
+	fmt.Println("  specs mv 03 07                          # Renumera 03-* para 07-*")
+	fmt.Println("  specs mv specs-validate validacao       # Renomeia mantendo a numeração")
+	fmt.Println("  specs mv 03-specs-validate api/ --alias # Move para specs/api/ e registra o nome anterior")
+	fmt.Println("  specs mv 03 07 --dry-run                # Mostra o que seria alterado")
+}
@@ -0,0 +1,293 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dreibox/specs/internal/adapters"
+	baselineSvc "github.com/dreibox/specs/internal/services/baseline"
+	configSvc "github.com/dreibox/specs/internal/services/config"
+	inspectorSvc "github.com/dreibox/specs/internal/services/inspector"
+)
+
+// ShowCommand implementa o comando show
+type ShowCommand struct {
+	fs           adapters.FileSystem
+	inspectorSvc *inspectorSvc.Service
+	configSvc    *configSvc.Service
+	baselineSvc  *baselineSvc.Service
+}
+
+// NewShowCommand cria uma nova instância do ShowCommand
+func NewShowCommand(fs adapters.FileSystem) *ShowCommand {
+	return &ShowCommand{
+		fs:           fs,
+		inspectorSvc: inspectorSvc.NewService(fs),
+		configSvc:    configSvc.NewService(fs),
+		baselineSvc:  baselineSvc.NewService(fs),
+	}
+}
+
+// Execute executa o comando show
+func (c *ShowCommand) Execute(args []string) int {
+	// Parsear flags e argumentos
+	opts, err := c.parseArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Verificar flag --help
+	if opts.Help {
+		c.printHelp()
+		return 0
+	}
+
+	// Resolver caminho padrão se não fornecido
+	path := opts.Path
+	if path == "" {
+		resolvedPath, err := c.configSvc.ResolveDefaultPath()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+		path = resolvedPath
+	}
+
+	// Esquema de numeração configurado (specs.numbering)
+	scheme, err := c.configSvc.NumberingScheme()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Carregar baseline de problemas pré-existentes
+	var baseline *baselineSvc.Baseline
+	if !opts.NoBaseline {
+		baseline, err = c.baselineSvc.Find(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+	}
+
+	// Localizar a spec e reunir informações
+	result, err := c.inspectorSvc.Inspect(inspectorSvc.InspectOptions{
+		Path:     path,
+		Spec:     opts.Spec,
+		Baseline: baseline,
+
+		Numbering: scheme,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	c.printResult(result, opts.Spec)
+	return 0
+}
+
+// showOptions contém opções do comando show
+type showOptions struct {
+	Spec       string
+	Path       string
+	NoBaseline bool
+	Help       bool
+}
+
+// parseArgs parseia argumentos e flags
+func (c *ShowCommand) parseArgs(args []string) (*showOptions, error) {
+	opts := &showOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--help" || arg == "-h":
+			opts.Help = true
+			return opts, nil
+		case arg == "--no-baseline":
+			opts.NoBaseline = true
+		case isFlag(arg, "--path"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			opts.Path = value
+		default:
+			if strings.HasPrefix(arg, "-") {
+				return nil, fmt.Errorf("flag desconhecida: %s", arg)
+			}
+			positional = append(positional, arg)
+		}
+	}
+
+	if len(positional) == 0 {
+		return nil, fmt.Errorf("uso: specs show <spec> (veja specs show --help)")
+	}
+	// Várias palavras formam uma única referência (ex.: specs show listagem de specs)
+	opts.Spec = strings.Join(positional, " ")
+	return opts, nil
+}
+
+// showKnownFields são as chaves do frontmatter exibidas em linhas próprias
+var showKnownFields = map[string]bool{"title": true, "status": true, "owner": true, "tags": true, "depends_on": true, "aliases": true}
+
+// printResult exibe as informações da spec
+func (c *ShowCommand) printResult(result *inspectorSvc.InspectResult, ref string) {
+	relPath := result.Path
+	if wd, err := c.fs.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, result.Path); err == nil && !strings.HasPrefix(rel, "..") {
+			relPath = rel
+		}
+	}
+
+	fmt.Printf("📄 %s\n", result.Title)
+	fmt.Printf("   %s\n", relPath)
+	if result.Fuzzy {
+		fmt.Printf("   (correspondência aproximada para '%s')\n", ref)
+	}
+
+	// Metadados
+	meta := result.Metadata
+	fmt.Println()
+	fmt.Println("Metadados:")
+	printShowField("Numeração", result.Number)
+	printShowField("Nome", result.Name)
+	printShowField("Status", meta.Status)
+	printShowField("Responsável", meta.Owner)
+	printShowField("Tags", strings.Join(meta.Tags, ", "))
+	printShowField("Depende de", strings.Join(meta.DependsOn, ", "))
+	printShowField("Aliases", strings.Join(meta.Aliases, ", "))
+	var extra []string
+	for key := range meta.Fields {
+		if !showKnownFields[key] {
+			extra = append(extra, key)
+		}
+	}
+	sort.Strings(extra)
+	for _, key := range extra {
+		printShowField(key, strings.Join(meta.Fields[key], ", "))
+	}
+	printShowField("Tamanho", fmt.Sprintf("%d linhas, %d palavras", result.Lines, result.Words))
+	printShowField("Modificada em", formatModTime(result.ModTime))
+
+	// Estrutura
+	fmt.Println()
+	fmt.Printf("Estrutura (%d seções):\n", len(result.Sections))
+	for _, section := range result.Sections {
+		indent := strings.Repeat("  ", section.Level-2)
+		fmt.Printf("  %4d  %s%s (%d palavras)\n", section.Line, indent, section.Title, section.Words)
+	}
+
+	// Checklist
+	fmt.Println()
+	if len(result.Checklist) == 0 {
+		fmt.Println("Checklist: não encontrado")
+	} else {
+		fmt.Printf("Checklist (%d/%d):\n", result.Validation.Checklist.MarkedCount, result.Validation.Checklist.ItemCount)
+		for _, item := range result.Checklist {
+			mark := " "
+			if item.Checked {
+				mark = "x"
+			}
+			fmt.Printf("  [%s] %s\n", mark, item.Text)
+		}
+	}
+
+	// Requisitos
+	fmt.Println()
+	fmt.Printf("Requisitos (%d):\n", len(result.Requirements))
+	for _, req := range result.Requirements {
+		fmt.Printf("  %-6s %s\n", req.ID, req.Title)
+	}
+
+	// Links
+	fmt.Println()
+	fmt.Printf("Links de saída (%d):\n", len(result.Outbound)+len(result.Unresolved))
+	for _, link := range result.Outbound {
+		fmt.Printf("  → %s%s [%s, linha %d]\n", link.ID, showTitle(link.Title), link.Kind, link.Line)
+	}
+	for _, u := range result.Unresolved {
+		fmt.Printf("  ❌ %s [depends_on, linha %d]: %s\n", u.Target, u.Line, u.Reason)
+	}
+	fmt.Println()
+	fmt.Printf("Links de entrada (%d):\n", len(result.Inbound))
+	for _, link := range result.Inbound {
+		fmt.Printf("  ← %s%s [%s, linha %d]\n", link.ID, showTitle(link.Title), link.Kind, link.Line)
+	}
+
+	// Validação
+	vr := result.Validation
+	fmt.Println()
+	fmt.Println("Validação:")
+	switch {
+	case len(vr.Errors) > 0:
+		fmt.Printf("  ❌ Erro (%d)\n", len(vr.Errors))
+		for _, err := range vr.Errors {
+			fmt.Printf("     - %s\n", err)
+		}
+	case vr.Complete:
+		fmt.Printf("  ✅ Completa (%d/6 itens do checklist)\n", vr.Checklist.MarkedCount)
+	default:
+		fmt.Printf("  ⚠️  Incompleta (%d/6 itens do checklist)\n", vr.Checklist.MarkedCount)
+	}
+	for _, msg := range vr.Placeholders {
+		fmt.Printf("     - %s\n", msg)
+	}
+	for _, msg := range vr.Warnings {
+		fmt.Printf("  ⚠️  %s\n", msg)
+	}
+	for _, msg := range vr.UnusedSuppressions {
+		fmt.Printf("  ⚠️  %s\n", msg)
+	}
+	if vr.Suppressed > 0 {
+		fmt.Printf("  Suprimidos pelo baseline: %d erro(s)\n", vr.Suppressed)
+	}
+}
+
+// printShowField exibe um metadado, omitindo valores vazios
+func printShowField(label string, value string) {
+	if value != "" {
+		fmt.Printf("  %-15s %s\n", label+":", value)
+	}
+}
+
+// showTitle formata o título de uma spec referenciada
+func showTitle(title string) string {
+	if title == "" {
+		return ""
+	}
+	return " (" + title + ")"
+}
+
+func (c *ShowCommand) printHelp() {
+	fmt.Println("Exibe os detalhes de uma spec: metadados, estrutura, checklist, requisitos, links e validação.")
+	fmt.Println()
+	fmt.Println("Uso:")
+	fmt.Println("  specs show <spec> [flags]")
+	fmt.Println()
+	fmt.Println("Argumentos:")
+	fmt.Println("  <spec>   Numeração (04), nome completo (04-specs-list), nome sem numeração (specs-list),")
+	fmt.Println("           alias, arquivo ou parte do nome/título (listagem); erros de digitação são tolerados")
+	fmt.Println()
+	fmt.Println("Flags:")
+	fmt.Println("  --path <caminho>   Diretório de specs (padrão: caminho configurado ou ./specs)")
+	fmt.Println("  --no-baseline      Ignora o baseline e reporta todos os erros de validação")
+	fmt.Println("  --help             Exibe ajuda para este comando")
+	fmt.Println()
+	fmt.Println("Exemplos:")
+	fmt.Println("  specs show 04                # Spec 04")
+	fmt.Println("  specs show specs-list        # Pelo nome sem numeração")
+	fmt.Println("  specs show listagem          # Por parte do título")
+	fmt.Println("  specs show api/01 --path docs/specs")
+	fmt.Println()
+	fmt.Println("Códigos de saída:")
+	fmt.Println("  0  Sucesso (a validação da spec é apenas exibida)")
+	fmt.Println("  1  Erro de configuração")
+	fmt.Println("  2  Spec não encontrada, ambígua ou input inválido")
+}
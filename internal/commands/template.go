@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+// templateFlags contém os valores de --template e --template-file
+type templateFlags struct {
+	Text string
+	File string
+}
+
+// set indica se alguma das flags de template foi informada
+func (f templateFlags) set() bool {
+	return f.Text != "" || f.File != ""
+}
+
+// parseTemplateFlag trata --template e --template-file (nas formas "--flag valor" e
+// "--flag=valor"); retorna false se arg não é uma flag de template
+func parseTemplateFlag(args []string, i *int, flags *templateFlags) (bool, error) {
+	var err error
+	switch arg := args[*i]; {
+	case isFlag(arg, "--template"):
+		flags.Text, err = flagValue(args, i)
+	case isFlag(arg, "--template-file"):
+		flags.File, err = flagValue(args, i)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// reportTemplate é um template Go (text/template) para a saída de um comando.
+//
+// O corpo do template é aplicado a cada item do resultado (spec ou problema), e a saída de
+// cada item ocupa suas próprias linhas: quebras de linha no início e no fim são normalizadas
+// para uma única quebra final (assim as linhas dos blocos define não geram linhas em branco).
+// Itens cuja saída é vazia são omitidos, o que permite filtrar com {{if}}. Os blocos
+// opcionais {{define "header"}} e {{define "footer"}} recebem o resultado completo e são
+// executados uma vez, antes e depois dos itens.
+type reportTemplate struct {
+	tmpl *template.Template
+}
+
+// templateFuncs são as funções disponíveis nos templates, além das nativas (printf, len, ...)
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// loadReportTemplate compila o template das flags, lendo o arquivo quando --template-file é
+// informado. Retorna nil quando nenhuma flag de template foi usada.
+func loadReportTemplate(fs adapters.FileSystem, flags templateFlags) (*reportTemplate, error) {
+	if !flags.set() {
+		return nil, nil
+	}
+	if flags.Text != "" && flags.File != "" {
+		return nil, fmt.Errorf("--template e --template-file não podem ser combinados")
+	}
+
+	text := flags.Text
+	if flags.File != "" {
+		data, err := fs.ReadFile(flags.File)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler template %s: %w", flags.File, err)
+		}
+		text = string(data)
+	}
+
+	tmpl, err := template.New("specs").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("template inválido: %w", err)
+	}
+	return &reportTemplate{tmpl: tmpl}, nil
+}
+
+// render executa o template para o resultado completo e seus itens, escrevendo em out
+// apenas se todas as execuções tiverem sucesso
+func (r *reportTemplate) render(out io.Writer, result interface{}, items []interface{}) error {
+	var b bytes.Buffer
+
+	if header := r.tmpl.Lookup("header"); header != nil {
+		if err := header.Execute(&b, result); err != nil {
+			return fmt.Errorf("falha ao executar template: %w", err)
+		}
+	}
+
+	for _, item := range items {
+		var itemOut bytes.Buffer
+		if err := r.tmpl.Execute(&itemOut, item); err != nil {
+			return fmt.Errorf("falha ao executar template: %w", err)
+		}
+		text := strings.Trim(itemOut.String(), "\r\n")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		b.WriteString(text)
+		b.WriteByte('\n')
+	}
+
+	if footer := r.tmpl.Lookup("footer"); footer != nil {
+		if err := footer.Execute(&b, result); err != nil {
+			return fmt.Errorf("falha ao executar template: %w", err)
+		}
+	}
+
+	_, err := out.Write(b.Bytes())
+	return err
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func TestReportTemplate_Render(t *testing.T) {
+	fs := adapters.NewFileSystem()
+
+	type item struct {
+		Name string
+		Tags []string
+	}
+	type report struct {
+		Items []item
+		Total int
+	}
+	result := report{
+		Items: []item{{"a", []string{"cli", "api"}}, {"b", nil}, {"c", []string{"cli"}}},
+		Total: 3,
+	}
+	items := make([]interface{}, len(result.Items))
+	for i, it := range result.Items {
+		items[i] = it
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"um item por linha", "{{.Name}}", "a\nb\nc\n"},
+		{"nova linha não duplicada", "{{.Name}}\n", "a\nb\nc\n"},
+		{"funções", "{{upper .Name}}={{join .Tags \",\"}}", "A=cli,api\nB=\nC=cli\n"},
+		{"itens vazios omitidos", "{{if .Tags}}{{.Name}}{{end}}", "a\nc\n"},
+		{"cabeçalho e rodapé", "{{define \"header\"}}# {{.Total}} itens\n{{end}}- {{.Name}}{{define \"footer\"}}fim\n{{end}}", "# 3 itens\n- a\n- b\n- c\nfim\n"},
+		{"json", "{{json .Tags}}", "[\"cli\",\"api\"]\nnull\n[\"cli\"]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := loadReportTemplate(fs, templateFlags{Text: tt.template})
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			var out strings.Builder
+			if err := tmpl.render(&out, result, items); err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("saída inesperada:\n  obtido:   %q\n  esperado: %q", out.String(), tt.expected)
+			}
+		})
+	}
+
+	// Campo inexistente falha sem escrever saída parcial
+	tmpl, err := loadReportTemplate(fs, templateFlags{Text: "{{.Name}} {{.Owner}}"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	var out strings.Builder
+	if err := tmpl.render(&out, result, items); err == nil || out.Len() > 0 {
+		t.Errorf("esperado erro sem saída, obtido err=%v saída=%q", err, out.String())
+	}
+}
+
+func TestLoadReportTemplate(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	file := filepath.Join(t.TempDir(), "relatorio.tmpl")
+	if err := fs.WriteFile(file, []byte("{{.Name}}"), 0644); err != nil {
+		t.Fatalf("falha ao criar template: %v", err)
+	}
+
+	if tmpl, err := loadReportTemplate(fs, templateFlags{}); tmpl != nil || err != nil {
+		t.Errorf("sem flags deveria retornar nil, nil; obtido %v, %v", tmpl, err)
+	}
+	if tmpl, err := loadReportTemplate(fs, templateFlags{File: file}); tmpl == nil || err != nil {
+		t.Errorf("template de arquivo deveria ser carregado; obtido %v, %v", tmpl, err)
+	}
+
+	for _, flags := range []templateFlags{
+		{Text: "{{.Name}}", File: file},
+		{Text: "{{.Name"},
+		{File: filepath.Join(t.TempDir(), "inexistente.tmpl")},
+	} {
+		if _, err := loadReportTemplate(fs, flags); err == nil {
+			t.Errorf("loadReportTemplate(%+v) deveria retornar erro", flags)
+		}
+	}
+}
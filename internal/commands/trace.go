@@ -0,0 +1,248 @@
+package commands
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dreibox/specs/internal/adapters"
+	configSvc "github.com/dreibox/specs/internal/services/config"
+	traceSvc "github.com/dreibox/specs/internal/services/trace"
+)
+
+// Formatos de saída da matriz de rastreabilidade
+const (
+	traceFormatTable = "table"
+	traceFormatCSV   = "csv"
+	traceFormatJSON  = "json"
+)
+
+// TraceCommand implementa o comando trace
+type TraceCommand struct {
+	fs        adapters.FileSystem
+	traceSvc  *traceSvc.Service
+	configSvc *configSvc.Service
+}
+
+// NewTraceCommand cria uma nova instância do TraceCommand
+func NewTraceCommand(fs adapters.FileSystem) *TraceCommand {
+	return &TraceCommand{
+		fs:        fs,
+		traceSvc:  traceSvc.NewService(fs),
+		configSvc: configSvc.NewService(fs),
+	}
+}
+
+// Execute executa o comando trace
+func (c *TraceCommand) Execute(args []string) int {
+	// Parsear flags e argumentos
+	opts, err := c.parseArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Verificar flag --help
+	if opts.Help {
+		c.printHelp()
+		return 0
+	}
+
+	// Resolver caminho padrão se não fornecido
+	path := opts.Path
+	if path == "" {
+		resolvedPath, err := c.configSvc.ResolveDefaultPath()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+		path = resolvedPath
+	}
+
+	// Gerar matriz
+	result, err := c.traceSvc.Trace(traceSvc.TraceOptions{
+		Path: path,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
+	// Exibir matriz no formato solicitado
+	switch opts.Format {
+	case traceFormatCSV:
+		err = c.printCSV(result)
+	case traceFormatJSON:
+		err = c.printJSON(result)
+	default:
+		c.printTable(result)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+
+	// Requisitos sem critério de aceite ou teste falham o comando
+	if result.Uncovered > 0 {
+		return 1
+	}
+	return 0
+}
+
+// traceOptions contém opções do comando trace
+type traceOptions struct {
+	Path   string
+	Format string
+	Help   bool
+}
+
+// parseArgs parseia argumentos e flags
+func (c *TraceCommand) parseArgs(args []string) (*traceOptions, error) {
+	opts := &traceOptions{Format: traceFormatTable}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--help" || arg == "-h":
+			opts.Help = true
+			return opts, nil
+		case arg == "--format":
+			if i+1 >= len(args) || strings.HasPrefix(args[i+1], "-") {
+				return nil, fmt.Errorf("flag --format requer um valor (table, csv ou json)")
+			}
+			i++
+			opts.Format = args[i]
+		case strings.HasPrefix(arg, "--format="):
+			opts.Format = strings.TrimPrefix(arg, "--format=")
+		case strings.HasPrefix(arg, "-"):
+			return nil, fmt.Errorf("flag desconhecida: %s", arg)
+		default:
+			if opts.Path == "" {
+				opts.Path = arg
+			}
+		}
+	}
+
+	switch opts.Format {
+	case traceFormatTable, traceFormatCSV, traceFormatJSON:
+	default:
+		return nil, fmt.Errorf("formato inválido: %s (use table, csv ou json)", opts.Format)
+	}
+
+	return opts, nil
+}
+
+// printTable exibe a matriz em formato de tabela
+func (c *TraceCommand) printTable(result *traceSvc.TraceResult) {
+	fmt.Println("Rastreabilidade de requisitos (RF → Critérios de Aceite → Testes)")
+	fmt.Println()
+
+	if len(result.Specs) == 0 {
+		fmt.Println("Nenhum requisito funcional (RFNN) encontrado.")
+		return
+	}
+
+	for _, spec := range result.Specs {
+		fmt.Println(spec.File)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "  Requisito\tCritérios\tTestes\tTítulo")
+		for _, req := range spec.Requirements {
+			icon := "✅"
+			if !req.Covered {
+				icon = "❌"
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s %s\n", req.ID, formatLines(req.Criteria), formatLines(req.Tests), icon, req.Title)
+		}
+		w.Flush()
+		fmt.Println()
+	}
+
+	fmt.Println("Resumo:")
+	fmt.Printf("  Total de requisitos: %d\n", result.TotalRequirements)
+	fmt.Printf("  Cobertos: %d\n", result.Covered)
+	fmt.Printf("  Sem cobertura: %d\n", result.Uncovered)
+}
+
+// printCSV exibe a matriz em CSV (uma linha por requisito)
+func (c *TraceCommand) printCSV(result *traceSvc.TraceResult) error {
+	w := csv.NewWriter(os.Stdout)
+	if err := w.Write([]string{"spec", "requisito", "titulo", "criterios", "testes", "coberto"}); err != nil {
+		return err
+	}
+	for _, spec := range result.Specs {
+		for _, req := range spec.Requirements {
+			record := []string{
+				spec.File,
+				req.ID,
+				req.Title,
+				joinLines(req.Criteria),
+				joinLines(req.Tests),
+				strconv.FormatBool(req.Covered),
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// printJSON exibe a matriz em JSON
+func (c *TraceCommand) printJSON(result *traceSvc.TraceResult) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("falha ao gerar JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// formatLines formata linhas para a tabela (ex.: "l.120, l.124" ou "-")
+func formatLines(lines []int) string {
+	if len(lines) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(lines))
+	for i, line := range lines {
+		parts[i] = fmt.Sprintf("l.%d", line)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// joinLines junta números de linha separados por espaço (para CSV)
+func joinLines(lines []int) string {
+	parts := make([]string, len(lines))
+	for i, line := range lines {
+		parts[i] = strconv.Itoa(line)
+	}
+	return strings.Join(parts, " ")
+}
+
+func (c *TraceCommand) printHelp() {
+	fmt.Println("Gera matriz de rastreabilidade entre requisitos funcionais, critérios de aceite e testes.")
+	fmt.Println()
+	fmt.Println("Uso:")
+	fmt.Println("  specs trace [caminho] [flags]")
+	fmt.Println()
+	fmt.Println("Flags:")
+	fmt.Println("  --format <formato>   Formato de saída: table (padrão), csv ou json")
+	fmt.Println("  --help               Exibe ajuda para este comando")
+	fmt.Println()
+	fmt.Println("Um requisito (RFNN na seção 2) é considerado coberto quando é mencionado")
+	fmt.Println("na seção 8 (Critérios de Aceite) e na seção 9 (Testes).")
+	fmt.Println()
+	fmt.Println("Exemplos:")
+	fmt.Println("  specs trace                       # Matriz de todas as specs")
+	fmt.Println("  specs trace specs/03-x.spec.md    # Matriz de uma spec")
+	fmt.Println("  specs trace --format csv > m.csv  # Exporta a matriz em CSV")
+	fmt.Println()
+	fmt.Println("Códigos de saída:")
+	fmt.Println("  0  Todos os requisitos cobertos")
+	fmt.Println("  1  Há requisitos sem critério de aceite ou teste")
+	fmt.Println("  2  Erro de input inválido")
+}
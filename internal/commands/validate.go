@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/dreibox/specs/internal/adapters"
+	baselineSvc "github.com/dreibox/specs/internal/services/baseline"
 	configSvc "github.com/dreibox/specs/internal/services/config"
 	validatorSvc "github.com/dreibox/specs/internal/services/validator"
 )
@@ -16,6 +17,8 @@ type ValidateCommand struct {
 	fs          adapters.FileSystem
 	validatorSvc *validatorSvc.Service
 	configSvc   *configSvc.Service
+	baselineSvc *baselineSvc.Service
+	git         adapters.Git
 }
 
 // NewValidateCommand cria uma nova instância do ValidateCommand
@@ -24,6 +27,8 @@ func NewValidateCommand(fs adapters.FileSystem) *ValidateCommand {
 		fs:          fs,
 		validatorSvc: validatorSvc.NewService(fs),
 		configSvc:   configSvc.NewService(fs),
+		baselineSvc: baselineSvc.NewService(fs),
+		git:         adapters.NewGit(),
 	}
 }
 
@@ -42,6 +47,13 @@ func (c *ValidateCommand) Execute(args []string) int {
 		return 0
 	}
 
+	// Template de saída (--template ou --template-file)
+	tmpl, err := loadReportTemplate(c.fs, opts.Template)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
 	// Resolver caminho padrão se não fornecido
 	path := opts.Path
 	if path == "" {
@@ -53,17 +65,51 @@ func (c *ValidateCommand) Execute(args []string) int {
 		path = resolvedPath
 	}
 
+	// Restringir a specs alteradas desde a revisão informada
+	var files []string
+	if opts.ChangedSince != "" {
+		files, err = changedSpecFiles(c.fs, c.git, path, opts.ChangedSince)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 2
+		}
+		if len(files) == 0 && !opts.Watch {
+			fmt.Printf("Nenhuma spec alterada desde %s\n", opts.ChangedSince)
+			return 0
+		}
+	}
+
+	// Carregar baseline de problemas pré-existentes
+	var baseline *baselineSvc.Baseline
+	if !opts.NoBaseline {
+		baseline, err = c.baselineSvc.Find(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			return 1
+		}
+	}
+
 	// Executar validação
 	result, err := c.validatorSvc.Validate(validatorSvc.ValidateOptions{
-		Path: path,
+		Path:     path,
+		Files:    files,
+		Baseline: baseline,
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
 		return 2
 	}
 
+	// Modo watch: revalidar apenas arquivos alterados
+	if opts.Watch {
+		return c.watch(path, files, result, baseline, tmpl)
+	}
+
 	// Exibir resultados
-	c.printResults(result)
+	if err := c.printOutput(result, tmpl); err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
 
 	// Determinar código de saída
 	if result.WithErrors > 0 {
@@ -74,20 +120,41 @@ func (c *ValidateCommand) Execute(args []string) int {
 
 // validateOptions contém opções do comando validate
 type validateOptions struct {
-	Path string
-	Help bool
+	Path         string
+	Watch        bool
+	ChangedSince string
+	NoBaseline   bool
+	Template     templateFlags
+	Help         bool
 }
 
 // parseArgs parseia argumentos e flags
 func (c *ValidateCommand) parseArgs(args []string) (*validateOptions, error) {
 	opts := &validateOptions{}
 
-	for _, arg := range args {
-		switch arg {
-		case "--help", "-h":
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if ok, err := parseTemplateFlag(args, &i, &opts.Template); ok {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		switch {
+		case arg == "--help" || arg == "-h":
 			opts.Help = true
 			return opts, nil
-		case "--json":
+		case arg == "--watch":
+			opts.Watch = true
+		case arg == "--no-baseline":
+			opts.NoBaseline = true
+		case isFlag(arg, "--changed-since"):
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			opts.ChangedSince = value
+		case arg == "--json":
 			// Flag para futuro (v2)
 			// Por enquanto ignorar
 		default:
@@ -104,6 +171,45 @@ func (c *ValidateCommand) parseArgs(args []string) (*validateOptions, error) {
 	return opts, nil
 }
 
+// watch observa o caminho e revalida specs alteradas. Se files não for nil (--changed-since),
+// apenas essas specs são revalidadas, para que o resultado continue restrito ao diff inicial.
+func (c *ValidateCommand) watch(path string, files []string, result *validatorSvc.ValidateResult, baseline *baselineSvc.Baseline, tmpl *reportTemplate) int {
+	root := path
+	var singleFile []string
+	if stat, err := c.fs.Stat(path); err == nil && !stat.IsDir() {
+		root = filepath.Dir(path)
+		singleFile = []string{path}
+	}
+
+	return runWatch(c.fs, root, func(changed []string) {
+		if singleFile != nil {
+			changed = validatorSvc.RestrictTo(changed, singleFile)
+		}
+		if files != nil {
+			changed = validatorSvc.RestrictTo(changed, files)
+		}
+		if len(changed) > 0 {
+			result = c.validatorSvc.Revalidate(result, changed, baseline)
+		}
+		if err := c.printOutput(result, tmpl); err != nil {
+			fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		}
+	})
+}
+
+// printOutput exibe os resultados ou, se informado, aplica o template a cada spec validada
+func (c *ValidateCommand) printOutput(result *validatorSvc.ValidateResult, tmpl *reportTemplate) error {
+	if tmpl == nil {
+		c.printResults(result)
+		return nil
+	}
+	items := make([]interface{}, len(result.Results))
+	for i, vr := range result.Results {
+		items[i] = vr
+	}
+	return tmpl.render(os.Stdout, result, items)
+}
+
 // printResults exibe resultados da validação
 func (c *ValidateCommand) printResults(result *validatorSvc.ValidateResult) {
 	// Determinar se é diretório ou arquivo único
@@ -149,6 +255,13 @@ func (c *ValidateCommand) printResults(result *validatorSvc.ValidateResult) {
 			// Spec incompleta
 			fmt.Printf("⚠️  %s: Incompleta (%d/6 itens do checklist)\n", relPath, vr.Checklist.MarkedCount)
 		}
+
+		for _, msg := range vr.Placeholders {
+			fmt.Printf("   - %s\n", msg)
+		}
+		for _, msg := range vr.UnusedSuppressions {
+			fmt.Printf("   ⚠️  %s\n", msg)
+		}
 	}
 
 	// Exibir resumo se houver múltiplos arquivos
@@ -160,6 +273,18 @@ func (c *ValidateCommand) printResults(result *validatorSvc.ValidateResult) {
 		fmt.Printf("  Incompletas: %d\n", result.Incomplete)
 		fmt.Printf("  Com erros: %d\n", result.WithErrors)
 	}
+
+	if result.Suppressed > 0 || result.UnusedSuppressions > 0 {
+		if !isDir {
+			fmt.Println()
+		}
+	}
+	if result.Suppressed > 0 {
+		fmt.Printf("  Suprimidos pelo baseline: %d erro(s)\n", result.Suppressed)
+	}
+	if result.UnusedSuppressions > 0 {
+		fmt.Printf("  Supressões não utilizadas: %d\n", result.UnusedSuppressions)
+	}
 }
 
 func (c *ValidateCommand) printHelp() {
@@ -169,10 +294,18 @@ func (c *ValidateCommand) printHelp() {
 	fmt.Println("  specs validate [caminho] [flags]")
 	fmt.Println()
 	fmt.Println("Flags:")
-	fmt.Println("  --help    Exibe ajuda para este comando")
+	fmt.Println("  --watch                  Observa alterações e revalida as specs modificadas")
+	fmt.Println("  --changed-since <rev>    Valida apenas specs adicionadas/modificadas desde a revisão git")
+	fmt.Println("  --no-baseline            Ignora o baseline e reporta todos os erros")
+	fmt.Println("  --template <template>    Formata cada spec com um template Go (campos de ValidationResult)")
+	fmt.Println("  --template-file <arq>    Lê o template de um arquivo")
+	fmt.Println("  --help                   Exibe ajuda para este comando")
 	fmt.Println()
 	fmt.Println("Exemplos:")
 	fmt.Println("  specs validate                    # Valida specs/ no diretório atual")
+	fmt.Println("  specs validate --watch            # Revalida a cada alteração salva")
+	fmt.Println("  specs validate --changed-since origin/main  # Apenas specs alteradas no branch")
 	fmt.Println("  specs validate specs/             # Valida diretório specs/")
 	fmt.Println("  specs validate specs/01-test.spec.md  # Valida arquivo específico")
+	fmt.Println("  specs validate --template '{{if .Errors}}{{.Path}}: {{join .Errors \"; \"}}{{end}}'")
 }
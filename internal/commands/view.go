@@ -42,6 +42,13 @@ func (c *ViewCommand) Execute(args []string) int {
 		return 0
 	}
 
+	// Template de saída (--template ou --template-file)
+	tmpl, err := loadReportTemplate(c.fs, opts.Template)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
+
 	// Resolver caminho padrão se não fornecido
 	path := opts.Path
 	if path == "" {
@@ -55,34 +62,83 @@ func (c *ViewCommand) Execute(args []string) int {
 
 	// Executar visualização
 	result, err := c.viewerSvc.View(viewerSvc.ViewOptions{
-		Path: path,
+		Path:    path,
+		GroupBy: opts.GroupBy,
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
 		return 2
 	}
 
+	// Modo watch: recalcular apenas specs alteradas
+	if opts.Watch {
+		return runWatch(c.fs, path, func(changed []string) {
+			if len(changed) > 0 {
+				result = c.viewerSvc.Refresh(result, path, changed)
+			}
+			if err := c.printOutput(result, opts, tmpl); err != nil {
+				fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+			}
+		})
+	}
+
 	// Exibir dashboard
-	c.printDashboard(result, opts)
+	if err := c.printOutput(result, opts, tmpl); err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 2
+	}
 
 	return 0
 }
 
+// printOutput exibe o dashboard ou, se informado, aplica o template a cada spec
+func (c *ViewCommand) printOutput(result *viewerSvc.DashboardResult, opts *viewOptions, tmpl *reportTemplate) error {
+	if tmpl == nil {
+		c.printDashboard(result, opts)
+		return nil
+	}
+	items := make([]interface{}, len(result.Specs))
+	for i, spec := range result.Specs {
+		items[i] = spec
+	}
+	return tmpl.render(os.Stdout, result, items)
+}
+
 // viewOptions contém opções do comando view
 type viewOptions struct {
-	Path string
-	Help bool
+	Path     string
+	Watch    bool
+	GroupBy  string
+	Template templateFlags
+	Help     bool
 }
 
 // parseArgs parseia argumentos e flags
 func (c *ViewCommand) parseArgs(args []string) (*viewOptions, error) {
 	opts := &viewOptions{}
 
-	for _, arg := range args {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if ok, err := parseTemplateFlag(args, &i, &opts.Template); ok {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if isFlag(arg, "--group-by") {
+			value, err := flagValue(args, &i)
+			if err != nil {
+				return nil, err
+			}
+			opts.GroupBy = value
+			continue
+		}
 		switch arg {
 		case "--help", "-h":
 			opts.Help = true
 			return opts, nil
+		case "--watch":
+			opts.Watch = true
 		case "--json":
 			// Flag para futuro (v2)
 			// Por enquanto ignorar
@@ -113,6 +169,11 @@ func (c *ViewCommand) printDashboard(result *viewerSvc.DashboardResult, opts *vi
 	fmt.Printf("  Progresso Geral: %s\n", result.OverallProgressStr)
 	fmt.Println()
 
+	if result.Groups != nil {
+		c.printGroups(result, opts)
+		return
+	}
+
 	// Separar specs completas e em progresso
 	var inProgress []viewerSvc.SpecStats
 	var complete []viewerSvc.SpecStats
@@ -157,6 +218,25 @@ func (c *ViewCommand) printDashboard(result *viewerSvc.DashboardResult, opts *vi
 	}
 }
 
+// printGroups exibe cada grupo com seus totais e progresso, seguido das specs do grupo
+func (c *ViewCommand) printGroups(result *viewerSvc.DashboardResult, opts *viewOptions) {
+	for i, group := range result.Groups {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s %s %d%%\n", groupLabel(opts.GroupBy, group.Key), c.generateProgressBar(group.Progress, 10), int(group.Progress*100))
+		fmt.Printf("  %d specs (%d completas, %d em progresso), %d requirements\n",
+			group.TotalSpecs, group.SpecsComplete, group.SpecsInProgress, group.Requirements)
+		for _, spec := range group.Specs {
+			if spec.Complete {
+				fmt.Printf("  ✅ %-27s %d requirements\n", spec.Name, spec.Requirements)
+			} else {
+				fmt.Printf("  %-30s %s %d%%\n", spec.Name, c.generateProgressBar(spec.Progress, 10), int(spec.Progress*100))
+			}
+		}
+	}
+}
+
 // generateProgressBar gera barra de progresso visual
 func (c *ViewCommand) generateProgressBar(progress float64, width int) string {
 	filled := int(progress * float64(width))
@@ -174,9 +254,16 @@ func (c *ViewCommand) printHelp() {
 	fmt.Println("  specs view [caminho] [flags]")
 	fmt.Println()
 	fmt.Println("Flags:")
-	fmt.Println("  --help    Exibe ajuda para este comando")
+	fmt.Println("  --watch                    Observa alterações e atualiza o dashboard")
+	fmt.Println("  --group-by <critério>      Agrupa por dir, tag, status ou owner, com totais e progresso por grupo")
+	fmt.Println("  --template <template>      Formata cada spec com um template Go (campos de SpecStats)")
+	fmt.Println("  --template-file <arquivo>  Lê o template de um arquivo")
+	fmt.Println("  --help                     Exibe ajuda para este comando")
 	fmt.Println()
 	fmt.Println("Exemplos:")
 	fmt.Println("  specs view                    # Dashboard de specs/ no diretório atual")
+	fmt.Println("  specs view --watch            # Dashboard atualizado a cada alteração")
+	fmt.Println("  specs view --group-by owner   # Progresso por responsável")
 	fmt.Println("  specs view specs/             # Dashboard de diretório específico")
+	fmt.Println("  specs view --template '{{.Number}}-{{.Name}} {{.MarkedItems}}/{{.TotalItems}}'")
 }
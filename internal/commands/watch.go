@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/dreibox/specs/internal/adapters"
+	watcherSvc "github.com/dreibox/specs/internal/services/watcher"
+)
+
+// clearScreen limpa o terminal antes de redesenhar o output
+const clearScreen = "\033[H\033[2J"
+
+// runWatch monitora o diretório de specs e redesenha o output a cada lote de alterações.
+// render recebe os arquivos alterados (nil na primeira execução). Retorna ao receber Ctrl+C.
+func runWatch(fs adapters.FileSystem, root string, render func(changed []string)) int {
+	redraw := func(changed []string) {
+		fmt.Print(clearScreen)
+		render(changed)
+		fmt.Println()
+		relRoot, _ := filepath.Rel(".", root)
+		if relRoot == "" {
+			relRoot = root
+		}
+		fmt.Printf("[%s] Observando alterações em %s... (Ctrl+C para sair)\n", time.Now().Format("15:04:05"), relRoot)
+	}
+
+	redraw(nil)
+
+	stop := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		<-signals
+		close(stop)
+	}()
+
+	err := watcherSvc.NewService(fs).Watch(watcherSvc.WatchOptions{Path: root}, stop, redraw)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "erro: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,256 @@
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+// FileName é o nome do arquivo de baseline, mantido na raiz do diretório de specs
+const FileName = ".specs-baseline.json"
+
+// Version é a versão atual do formato do arquivo de baseline
+const Version = 1
+
+// Origens de problemas registrados no baseline
+const (
+	SourceCheck    = "check"
+	SourceValidate = "validate"
+)
+
+// Service gerencia o baseline de problemas pré-existentes
+type Service struct {
+	fs adapters.FileSystem
+}
+
+// NewService cria uma nova instância do Service
+func NewService(fs adapters.FileSystem) *Service {
+	return &Service{fs: fs}
+}
+
+// Entry representa um problema registrado no baseline
+type Entry struct {
+	Fingerprint string `json:"fingerprint"`
+	Source      string `json:"source"`
+	Category    string `json:"category,omitempty"`
+	File        string `json:"file,omitempty"`
+	Message     string `json:"message"`
+	Count       int    `json:"count"`
+}
+
+// Baseline contém problemas aceitos que não devem ser reportados novamente
+type Baseline struct {
+	Version  int     `json:"version"`
+	Problems []Entry `json:"problems"`
+
+	root   string         // Diretório onde o baseline foi encontrado
+	counts map[string]int // fingerprint -> ocorrências aceitas
+}
+
+// NewEntry cria uma entrada com fingerprint estável (independente de número de linha).
+// file deve ser relativo à raiz do baseline.
+func NewEntry(source, category, file, message string) Entry {
+	file = filepath.ToSlash(file)
+	return Entry{
+		Fingerprint: Fingerprint(source, category, file, message),
+		Source:      source,
+		Category:    category,
+		File:        file,
+		Message:     message,
+		Count:       1,
+	}
+}
+
+// Fingerprint calcula identificador estável de um problema
+func Fingerprint(source, category, file, message string) string {
+	sum := sha256.Sum256([]byte(source + "\x00" + category + "\x00" + filepath.ToSlash(file) + "\x00" + message))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Root retorna o diretório onde o baseline está localizado
+func (b *Baseline) Root() string {
+	return b.root
+}
+
+// Allowed retorna quantas ocorrências de um fingerprint estão aceitas no baseline
+func (b *Baseline) Allowed(fingerprint string) int {
+	if b == nil {
+		return 0
+	}
+	return b.counts[fingerprint]
+}
+
+// RelPath converte caminho de arquivo para relativo à raiz do baseline
+func (b *Baseline) RelPath(path string) string {
+	return RelPath(b.root, path)
+}
+
+// RelPath converte caminho de arquivo para relativo a root, com separador "/"
+func RelPath(root string, path string) string {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	relPath, err := filepath.Rel(absRoot, absPath)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(relPath)
+}
+
+// Matcher consome ocorrências aceitas do baseline durante uma execução
+type Matcher struct {
+	baseline *Baseline
+	used     map[string]int
+}
+
+// NewMatcher cria um Matcher; baseline nil não suprime nada
+func (b *Baseline) NewMatcher() *Matcher {
+	return &Matcher{baseline: b, used: make(map[string]int)}
+}
+
+// Suppress indica se o problema está coberto pelo baseline, consumindo uma ocorrência
+func (m *Matcher) Suppress(entry Entry) bool {
+	if m.baseline == nil {
+		return false
+	}
+	if m.used[entry.Fingerprint] >= m.baseline.Allowed(entry.Fingerprint) {
+		return false
+	}
+	m.used[entry.Fingerprint]++
+	return true
+}
+
+// Path retorna o caminho do arquivo de baseline para um diretório de specs
+func (s *Service) Path(specsPath string) string {
+	return filepath.Join(specsPath, FileName)
+}
+
+// Load carrega o baseline do diretório de specs. Retorna nil se o arquivo não existir.
+func (s *Service) Load(specsPath string) (*Baseline, error) {
+	path := s.Path(specsPath)
+	if !s.fs.Exists(path) {
+		return nil, nil
+	}
+
+	data, err := s.fs.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler baseline: %w", err)
+	}
+
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("baseline inválido (%s): %w", path, err)
+	}
+	if b.Version > Version {
+		return nil, fmt.Errorf("versão de baseline não suportada: %d", b.Version)
+	}
+
+	b.root = specsPath
+	b.counts = make(map[string]int, len(b.Problems))
+	for _, e := range b.Problems {
+		count := e.Count
+		if count <= 0 {
+			count = 1
+		}
+		b.counts[e.Fingerprint] += count
+	}
+
+	return &b, nil
+}
+
+// Find procura o baseline a partir de start (arquivo ou diretório) subindo pelos diretórios pai
+// até a raiz do repositório (diretório com .git). Fora de um repositório, só o próprio diretório
+// de start é considerado, para que um baseline alheio em $HOME ou / não suprima problemas.
+// Retorna nil se nenhum baseline for encontrado.
+func (s *Service) Find(start string) (*Baseline, error) {
+	dir, err := filepath.Abs(start)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao resolver caminho: %w", err)
+	}
+	if stat, err := s.fs.Stat(dir); err == nil && !stat.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	stop := ProjectRoot(s.fs, dir)
+	for {
+		if s.fs.Exists(s.Path(dir)) {
+			return s.Load(dir)
+		}
+		parent := filepath.Dir(dir)
+		if dir == stop || parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// ProjectRoot retorna o diretório mais próximo acima de dir (inclusive) que contém .git, ou o
+// próprio dir quando não há repositório. Limita buscas que sobem pelos diretórios pai.
+func ProjectRoot(fs adapters.FileSystem, dir string) string {
+	for current := dir; ; {
+		if fs.Exists(filepath.Join(current, ".git")) {
+			return current
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
+
+// Save grava o baseline no diretório de specs, agregando ocorrências repetidas
+func (s *Service) Save(specsPath string, entries []Entry) (*Baseline, error) {
+	index := make(map[string]int)
+	problems := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		if i, ok := index[e.Fingerprint]; ok {
+			problems[i].Count += e.Count
+			continue
+		}
+		index[e.Fingerprint] = len(problems)
+		problems = append(problems, e)
+	}
+
+	// Ordem estável para facilitar revisão em diffs
+	sort.Slice(problems, func(i, j int) bool {
+		a, b := problems[i], problems[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		return a.Message < b.Message
+	})
+
+	b := &Baseline{
+		Version:  Version,
+		Problems: problems,
+	}
+
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("falha ao serializar baseline: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := s.fs.WriteFile(s.Path(specsPath), data, 0644); err != nil {
+		return nil, fmt.Errorf("falha ao salvar baseline: %w", err)
+	}
+
+	return s.Load(specsPath)
+}
@@ -0,0 +1,160 @@
+package baseline
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func TestFingerprint_Stable(t *testing.T) {
+	a := Fingerprint(SourceCheck, "Links", "01-test.spec.md", "Link para '02-x.spec.md' não encontrado")
+	b := Fingerprint(SourceCheck, "Links", "01-test.spec.md", "Link para '02-x.spec.md' não encontrado")
+	c := Fingerprint(SourceCheck, "Links", "02-test.spec.md", "Link para '02-x.spec.md' não encontrado")
+
+	if a != b {
+		t.Error("fingerprint deveria ser estável para o mesmo problema")
+	}
+	if a == c {
+		t.Error("fingerprint deveria diferir para arquivos diferentes")
+	}
+}
+
+func TestService_SaveAndLoad(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := t.TempDir()
+	link := NewEntry(SourceCheck, "Links", "01-test.spec.md", "Link para '09.spec.md' não encontrado")
+	gap := NewEntry(SourceCheck, "Numeração", "", "Gap detectado - falta 02")
+
+	// Mesmo problema duas vezes deve ser agregado com contador
+	saved, err := service.Save(specsDir, []Entry{link, gap, link})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(saved.Problems) != 2 {
+		t.Fatalf("esperado 2 entradas, obtido %d", len(saved.Problems))
+	}
+	if saved.Allowed(link.Fingerprint) != 2 {
+		t.Errorf("esperado contador 2, obtido %d", saved.Allowed(link.Fingerprint))
+	}
+
+	// Entradas ordenadas por arquivo (problemas sem arquivo primeiro)
+	if saved.Problems[0].Fingerprint != gap.Fingerprint {
+		t.Errorf("ordem inesperada: %v", saved.Problems)
+	}
+
+	loaded, err := service.Load(specsDir)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if loaded == nil || loaded.Allowed(gap.Fingerprint) != 1 {
+		t.Error("baseline carregado deveria conter o gap")
+	}
+}
+
+func TestService_Find(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := t.TempDir()
+	subDir := filepath.Join(specsDir, "api")
+	if err := fs.MkdirAll(filepath.Join(specsDir, ".git"), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	if err := fs.MkdirAll(subDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	specFile := filepath.Join(subDir, "01-test.spec.md")
+	if err := fs.WriteFile(specFile, []byte("# Test"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	b, err := service.Find(specFile)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if b != nil {
+		t.Fatal("não deveria encontrar baseline inexistente")
+	}
+
+	if _, err := service.Save(specsDir, nil); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	b, err = service.Find(specFile)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if b == nil {
+		t.Fatal("deveria encontrar baseline no diretório pai")
+	}
+	if got := b.RelPath(specFile); got != "api/01-test.spec.md" {
+		t.Errorf("esperado caminho relativo api/01-test.spec.md, obtido %s", got)
+	}
+}
+
+func TestService_Find_StopsAtRepoRoot(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	// Baseline alheio acima do repositório não deve ser usado
+	outside := t.TempDir()
+	if _, err := service.Save(outside, nil); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	repo := filepath.Join(outside, "repo")
+	specsDir := filepath.Join(repo, "specs")
+	if err := fs.MkdirAll(filepath.Join(repo, ".git"), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	b, err := service.Find(specsDir)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if b != nil {
+		t.Errorf("não deveria usar baseline fora do repositório: %s", b.Root())
+	}
+
+	// Sem repositório, só o próprio diretório é considerado
+	loose := filepath.Join(outside, "loose", "specs")
+	if err := fs.MkdirAll(loose, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	b, err = service.Find(loose)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if b != nil {
+		t.Errorf("não deveria subir além do diretório sem repositório: %s", b.Root())
+	}
+}
+
+func TestMatcher_Suppress(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	entry := NewEntry(SourceValidate, "", "01-test.spec.md", "seção 'Dados' faltando")
+	b, err := service.Save(t.TempDir(), []Entry{entry})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	matcher := b.NewMatcher()
+	if !matcher.Suppress(entry) {
+		t.Error("primeira ocorrência deveria ser suprimida")
+	}
+	if matcher.Suppress(entry) {
+		t.Error("ocorrência além do contador deveria ser reportada")
+	}
+
+	var none *Baseline
+	if none.NewMatcher().Suppress(entry) {
+		t.Error("baseline nil não deveria suprimir")
+	}
+}
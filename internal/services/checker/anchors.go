@@ -0,0 +1,73 @@
+package checker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	headingRegex    = regexp.MustCompile(`^#{1,6}\s+(.+?)(?:\s+#+)?\s*$`)
+	mdLinkRegex     = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	htmlTagRegex    = regexp.MustCompile(`<[^>]+>`)
+	htmlAnchorRegex = regexp.MustCompile(`<a\s+[^>]*(?:name|id)\s*=\s*["']([^"']+)["']`)
+)
+
+// headingAnchors retorna as âncoras de uma spec: slugs dos títulos no estilo do GitHub
+// (com sufixo -1, -2... para títulos repetidos) e âncoras HTML explícitas (<a name/id>).
+// Títulos dentro de blocos de código são ignorados.
+func headingAnchors(content string) map[string]bool {
+	anchors := make(map[string]bool)
+	counts := make(map[string]int)
+	inFence := false
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		for _, match := range htmlAnchorRegex.FindAllStringSubmatch(line, -1) {
+			anchors[match[1]] = true
+		}
+
+		match := headingRegex.FindStringSubmatch(trimmed)
+		if match == nil {
+			continue
+		}
+		slug := githubSlug(match[1])
+		if n := counts[slug]; n > 0 {
+			anchors[fmt.Sprintf("%s-%d", slug, n)] = true
+		} else {
+			anchors[slug] = true
+		}
+		counts[slug]++
+	}
+
+	return anchors
+}
+
+// githubSlug gera o slug de um título como o GitHub: texto renderizado em minúsculas,
+// sem pontuação (exceto - e _) e com espaços trocados por hífens.
+// Ex.: "2. Requisitos Funcionais" -> "2-requisitos-funcionais"
+func githubSlug(heading string) string {
+	text := mdLinkRegex.ReplaceAllString(heading, "$1")
+	text = htmlTagRegex.ReplaceAllString(text, "")
+	text = strings.NewReplacer("`", "", "*", "").Replace(text)
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		switch {
+		case r == ' ':
+			b.WriteRune('-')
+		case r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package checker
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func TestGithubSlug(t *testing.T) {
+	tests := map[string]string{
+		"2. Requisitos Funcionais":          "2-requisitos-funcionais",
+		"NFRs (Não Funcionais)":             "nfrs-não-funcionais",
+		"Migração / Rollback":               "migração--rollback",
+		"Flag `--format` e [link](x.md)":    "flag---format-e-link",
+		"**Negrito** snake_case":            "negrito-snake_case",
+		"Checklist Rápido (preencha antes)": "checklist-rápido-preencha-antes",
+	}
+	for heading, expected := range tests {
+		if slug := githubSlug(heading); slug != expected {
+			t.Errorf("githubSlug(%q) = %q, esperado %q", heading, slug, expected)
+		}
+	}
+}
+
+func TestHeadingAnchors(t *testing.T) {
+	content := "# Título\n## FAQ\n## FAQ ##\n```\n## Em código\n```\n<a name=\"manual\"></a>\n"
+	anchors := headingAnchors(content)
+
+	for _, anchor := range []string{"título", "faq", "faq-1", "manual"} {
+		if !anchors[anchor] {
+			t.Errorf("âncora %q deveria existir em %v", anchor, anchors)
+		}
+	}
+	if anchors["em-código"] {
+		t.Error("títulos em blocos de código não deveriam gerar âncoras")
+	}
+}
+
+func TestService_Check_Anchors(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := t.TempDir()
+	specs := map[string]string{
+		"01-a.spec.md": "# 01 A\n## 5. Dados\n[ok](#5-dados) [ruim](#6-dados)\n[ok](02-b.spec.md#1-contexto-e-objetivo) [ruim](02-b.spec.md#dados)\n[sem âncora](02-b.spec.md)\n",
+		"02-b.spec.md": "# 02 B\n## 1. Contexto e Objetivo\n",
+	}
+	for name, content := range specs {
+		if err := fs.WriteFile(filepath.Join(specsDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+
+	result, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	expected := []string{
+		"Âncora '#6-dados' não encontrada no próprio arquivo",
+		"Âncora '#dados' não encontrada em '02-b.spec.md'",
+	}
+	var messages []string
+	for _, p := range result.Problems {
+		if p.Category == "Links" {
+			messages = append(messages, p.Message)
+		}
+	}
+	if len(messages) != len(expected) {
+		t.Fatalf("esperados %d problemas de links, obtido %v", len(expected), messages)
+	}
+	for i := range expected {
+		if messages[i] != expected[i] {
+			t.Errorf("esperado %q, obtido %q", expected[i], messages[i])
+		}
+	}
+}
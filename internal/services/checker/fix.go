@@ -0,0 +1,795 @@
+package checker
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
+	"github.com/dreibox/specs/internal/services/suppression"
+	"github.com/dreibox/specs/internal/services/validator"
+)
+
+// fixTempSuffix é o sufixo dos arquivos temporários gravados antes de aplicar as correções
+const fixTempSuffix = ".specs-fix.tmp"
+
+var (
+	titleNumberRegex    = regexp.MustCompile(`^(#\s+[^\s\d]*)(\d+(?:\.\d+)*)\b`)
+	titlePrefixRegex    = regexp.MustCompile(`^\d+\s*[-.:–—]?\s*`)
+	frontmatterKeyRegex = regexp.MustCompile(`^[A-Za-z_][\w-]*\s*:`)
+	dependencyRefRegex  = regexp.MustCompile(`[^\s,\[\]"'#]+`)
+)
+
+// FixOptions contém opções da correção automática
+type FixOptions struct {
+	Path      string
+	DryRun    bool             // Apenas planeja as correções, sem gravar arquivos
+	Numbering numbering.Scheme // Esquema de numeração (valor zero = padrão de dois dígitos)
+}
+
+// Rename é a renomeação de uma spec (caminhos relativos ao diretório de specs)
+type Rename struct {
+	From   string
+	To     string
+	Reason string
+}
+
+// LineChange é uma linha alterada por uma correção
+type LineChange struct {
+	Line   int
+	Before string
+	After  string // Pode conter várias linhas quando linhas são inseridas (ex.: frontmatter com aliases)
+}
+
+// FileEdit contém as linhas alteradas de um arquivo (caminhos relativos ao diretório de specs)
+type FileEdit struct {
+	File    string
+	To      string // Caminho após a renomeação (igual a File se o arquivo não foi renomeado)
+	Changes []LineChange
+}
+
+// FixResult contém as renomeações e alterações planejadas (e aplicadas, se não for simulação)
+// por `check --fix` e `specs mv`
+type FixResult struct {
+	Renames  []Rename
+	Edits    []FileEdit
+	Kept     []string // Specs mantidas por supressão de numbering ou format no arquivo inteiro
+	Warnings []string
+	Applied  bool
+}
+
+// Empty indica se não há correções a fazer
+func (r *FixResult) Empty() bool {
+	return len(r.Renames) == 0 && len(r.Edits) == 0
+}
+
+// fixSpec é uma spec candidata à renomeação
+type fixSpec struct {
+	file      string
+	namespace string           // Namespace de numeração ("" = raiz)
+	number    numbering.Number // nil se a spec não tem numeração
+	name      string
+}
+
+// fixWrite é um arquivo a gravar: conteúdo novo no destino, original preservado para rollback
+type fixWrite struct {
+	from     string
+	to       string
+	content  []byte
+	original []byte
+	perm     os.FileMode
+}
+
+// Fix corrige problemas de numeração e de formato de nomes: renumera as specs em sequência
+// (fechando gaps e resolvendo duplicatas), normaliza nomes para {numero}-{nome}.spec.md e
+// reescreve links, números de títulos e depends_on que referenciam specs renomeadas.
+// As alterações são gravadas em conjunto: se alguma falhar, os arquivos originais são restaurados.
+func (s *Service) Fix(opts FixOptions) (*FixResult, error) {
+	basePath, err := s.resolvePath(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	specFiles, err := s.findSpecFiles(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
+	}
+	sort.Strings(specFiles)
+
+	result := &FixResult{}
+	renames := s.planRenames(specFiles, basePath, opts.Numbering, s.loadSuppressions(specFiles), result)
+	if err := s.rewriteReferences(basePath, specFiles, opts.Numbering, renames, nil, opts.DryRun, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// rewriteReferences renomeia as specs (caminho absoluto antigo -> novo) e atualiza as referências
+// a elas em todos os arquivos markdown do diretório. aliases indica, por spec renomeada, o nome
+// anterior a registrar no frontmatter. Com dryRun, apenas preenche o resultado.
+func (s *Service) rewriteReferences(basePath string, specFiles []string, scheme numbering.Scheme, renames map[string]string, aliases map[string]string, dryRun bool, result *FixResult) error {
+	refs := newDependencyRefs(basePath, specFiles, renames, scheme)
+	anchors, err := s.titleAnchors(renames, scheme)
+	if err != nil {
+		return err
+	}
+
+	// Markdown que pode referenciar specs (specs, checklist.md, README.md...)
+	var mdFiles []string
+	err = s.fs.Walk(basePath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && isMarkdown(p) {
+			mdFiles = append(mdFiles, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao listar arquivos: %w", err)
+	}
+	sort.Strings(mdFiles)
+
+	var writes []fixWrite
+	for _, file := range mdFiles {
+		target, renamed := renames[file]
+		data, err := s.fs.ReadFile(file)
+		if err != nil {
+			if renamed {
+				return fmt.Errorf("falha ao ler %s: %w", file, err)
+			}
+			continue
+		}
+
+		lines := strings.Split(string(data), "\n")
+		updated := append([]string(nil), lines...)
+		meta := metadata.Parse(string(data))
+		to := file
+		if renamed {
+			to = target
+			renumberTitle(updated, meta.EndLine, filepath.Base(file), filepath.Base(target), scheme)
+		}
+		rewriteLinks(file, to, updated, renames, anchors)
+		rewriteDependsOn(updated, meta, refs, scheme.Namespace(relativeTo(basePath, file)))
+		if alias, ok := aliases[file]; ok {
+			addAlias(updated, meta, alias)
+		}
+
+		var changes []LineChange
+		for i := range lines {
+			if lines[i] != updated[i] {
+				changes = append(changes, LineChange{Line: i + 1, Before: lines[i], After: updated[i]})
+			}
+		}
+		if len(changes) == 0 && !renamed {
+			continue
+		}
+
+		if len(changes) > 0 {
+			result.Edits = append(result.Edits, FileEdit{
+				File:    relativeTo(basePath, file),
+				To:      relativeTo(basePath, to),
+				Changes: changes,
+			})
+		}
+
+		perm := os.FileMode(0644)
+		if info, err := s.fs.Stat(file); err == nil {
+			perm = info.Mode().Perm()
+		}
+		writes = append(writes, fixWrite{
+			from:     file,
+			to:       to,
+			content:  []byte(strings.Join(updated, "\n")),
+			original: data,
+			perm:     perm,
+		})
+	}
+
+	if dryRun || len(writes) == 0 {
+		return nil
+	}
+	if err := s.applyWrites(writes); err != nil {
+		return err
+	}
+	result.Applied = true
+	return nil
+}
+
+// titleAnchors calcula, para cada spec renomeada, a âncora do título principal antes e depois da
+// renumeração (ex.: #03---nome -> #05---nome), para que links para o título continuem válidos
+func (s *Service) titleAnchors(renames map[string]string, scheme numbering.Scheme) (map[string][2]string, error) {
+	anchors := make(map[string][2]string)
+	for file, target := range renames {
+		data, err := s.fs.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler %s: %w", file, err)
+		}
+		lines := strings.Split(string(data), "\n")
+		end := metadata.Parse(string(data)).EndLine
+		before := titleLine(lines, end)
+		renumberTitle(lines, end, filepath.Base(file), filepath.Base(target), scheme)
+		if after := titleLine(lines, end); before != after {
+			anchors[file] = [2]string{githubSlug(before), githubSlug(after)}
+		}
+	}
+	return anchors, nil
+}
+
+// titleLine retorna o texto do título principal (após o frontmatter)
+func titleLine(lines []string, frontmatterEnd int) string {
+	for i := frontmatterEnd; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], "# ") {
+			return strings.TrimSpace(lines[i][2:])
+		}
+	}
+	return ""
+}
+
+// planRenames calcula o novo nome de cada spec (caminho absoluto antigo -> novo).
+// Specs numeradas mantêm a ordem relativa (número, caminho); specs sem número vão para o final.
+// Na numeração hierárquica, cada subnível é renumerado dentro do seu pai (03.1, 03.2...).
+// Specs com supressão de numbering ou format no arquivo inteiro não são renomeadas e seus números são reservados;
+// specs 00-* e o template ficam fora do planejamento (ver fixedName).
+func (s *Service) planRenames(files []string, basePath string, scheme numbering.Scheme, suppressions map[string]*suppression.Set, result *FixResult) map[string]string {
+	var specs []fixSpec
+	reserved := make(map[string]map[string]bool) // namespace -> numerações reservadas
+	start := make(map[string]int)                // namespace -> menor numeração existente
+
+	for _, file := range files {
+		base := filepath.Base(file)
+		number, rest := scheme.Loose(strings.TrimSuffix(base, ".spec.md"))
+		if fixedName(base, number) {
+			continue
+		}
+		spec := fixSpec{file: file, namespace: scheme.Namespace(relativeTo(basePath, file)), number: number, name: rest}
+		if first, ok := start[spec.namespace]; number != nil && (!ok || number[0] < first) {
+			start[spec.namespace] = number[0]
+		}
+
+		if keepsFileName(suppressions[file]) {
+			result.Kept = append(result.Kept, relativeTo(basePath, file))
+			if number != nil {
+				if reserved[spec.namespace] == nil {
+					reserved[spec.namespace] = make(map[string]bool)
+				}
+				reserved[spec.namespace][scheme.Format(number)] = true
+			}
+			continue
+		}
+
+		// Nomes já no formato são preservados; os demais são normalizados
+		if _, name, ok := scheme.Split(base); ok {
+			spec.name = name
+		} else {
+			spec.name = specSlug(spec.name)
+		}
+		if spec.name == "" {
+			if data, err := s.fs.ReadFile(file); err == nil {
+				spec.name = specSlug(titleName(string(data)))
+			}
+		}
+		if spec.name == "" {
+			spec.name = "spec"
+		}
+		specs = append(specs, spec)
+	}
+
+	sort.SliceStable(specs, func(i, j int) bool {
+		if specs[i].namespace != specs[j].namespace {
+			return specs[i].namespace < specs[j].namespace
+		}
+		if (specs[i].number != nil) != (specs[j].number != nil) {
+			return specs[i].number != nil
+		}
+		if c := specs[i].number.Compare(specs[j].number); c != 0 {
+			return c < 0
+		}
+		return specs[i].file < specs[j].file
+	})
+
+	// Cada namespace é renumerado em sequência própria
+	renames := make(map[string]string)
+	slots := make(map[string]*numberSlots)
+	for _, spec := range specs {
+		if slots[spec.namespace] == nil {
+			first, ok := start[spec.namespace]
+			if !ok {
+				first = 1
+			}
+			slots[spec.namespace] = newNumberSlots(scheme, first, reserved[spec.namespace])
+		}
+		next := slots[spec.namespace].assign(spec.number)
+		target := filepath.Join(filepath.Dir(spec.file), scheme.FileName(next, spec.name))
+		if target != spec.file {
+			renames[spec.file] = target
+
+			var reasons []string
+			switch {
+			case spec.number == nil:
+				reasons = append(reasons, fmt.Sprintf("sem numeração → %s", scheme.Format(next)))
+			case !spec.number.Equal(next):
+				reasons = append(reasons, fmt.Sprintf("numeração %s → %s", scheme.Format(spec.number), scheme.Format(next)))
+			}
+			if _, _, ok := scheme.Split(filepath.Base(spec.file)); !ok {
+				reasons = append(reasons, fmt.Sprintf("nome fora do padrão %s-{nome}.spec.md", scheme.Placeholder()))
+			}
+			result.Renames = append(result.Renames, Rename{
+				From:   relativeTo(basePath, spec.file),
+				To:     relativeTo(basePath, target),
+				Reason: strings.Join(reasons, ", "),
+			})
+		}
+	}
+
+	sort.Slice(result.Renames, func(i, j int) bool { return result.Renames[i].From < result.Renames[j].From })
+	return renames
+}
+
+// numberSlots distribui numerações em sequência preservando a hierarquia: o primeiro nível começa
+// em start e cada subnível em 1; numerações reservadas são puladas
+type numberSlots struct {
+	scheme   numbering.Scheme
+	start    int
+	reserved map[string]bool
+	next     map[string]int              // numeração nova do pai -> próximo valor
+	assigned map[string]numbering.Number // numeração antiga -> nova
+	taken    map[string]bool             // numerações antigas já usadas por uma spec
+}
+
+func newNumberSlots(scheme numbering.Scheme, start int, reserved map[string]bool) *numberSlots {
+	return &numberSlots{
+		scheme:   scheme,
+		start:    start,
+		reserved: reserved,
+		next:     make(map[string]int),
+		assigned: make(map[string]numbering.Number),
+		taken:    make(map[string]bool),
+	}
+}
+
+// assign retorna a nova numeração de uma spec. Subníveis acompanham a nova numeração do pai;
+// uma numeração repetida (duplicata) recebe a próxima posição livre do mesmo nível.
+func (n *numberSlots) assign(old numbering.Number) numbering.Number {
+	if old == nil {
+		return n.allocate(nil)
+	}
+	var parent numbering.Number
+	for depth := 1; depth <= len(old); depth++ {
+		key := n.scheme.Format(old[:depth])
+		last := depth == len(old)
+		mapped, ok := n.assigned[key]
+		if !ok || (last && n.taken[key]) {
+			mapped = n.allocate(parent)
+			if !ok {
+				n.assigned[key] = mapped
+			}
+		}
+		if last {
+			n.taken[key] = true
+		}
+		parent = mapped
+	}
+	return parent
+}
+
+// allocate reserva o próximo valor livre sob o pai informado (nil = primeiro nível)
+func (n *numberSlots) allocate(parent numbering.Number) numbering.Number {
+	key := n.scheme.Format(parent)
+	value, ok := n.next[key]
+	if !ok {
+		value = 1
+		if parent == nil {
+			value = n.start
+		}
+	}
+	for {
+		candidate := parent.Child(value)
+		value++
+		if !n.reserved[n.scheme.Format(candidate)] {
+			n.next[key] = value
+			return candidate
+		}
+	}
+}
+
+// fixedName indica se a spec fica fora da renumeração: specs de contexto raiz (00-*, pode
+// haver várias) e o template, que a validação procura pelo nome
+func fixedName(base string, number numbering.Number) bool {
+	return base == validator.TemplateFileName || (number != nil && number[0] == 0)
+}
+
+// keepsFileName indica se a spec suprime numbering ou format no arquivo inteiro
+func keepsFileName(set *suppression.Set) bool {
+	if set == nil {
+		return false
+	}
+	for _, d := range set.Directives {
+		if !d.NextLine && (suppression.Matches(d.Rule, suppression.RuleNumbering) || suppression.Matches(d.Rule, suppression.RuleFormat)) {
+			return true
+		}
+	}
+	return false
+}
+
+// titleName retorna o título da spec (frontmatter ou título principal) sem a numeração
+func titleName(content string) string {
+	if title := metadata.Parse(content).Title; title != "" {
+		return titlePrefixRegex.ReplaceAllString(title, "")
+	}
+	for _, line := range strings.Split(metadata.Body(content), "\n") {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "# ") {
+			return titlePrefixRegex.ReplaceAllString(strings.TrimSpace(trimmed[2:]), "")
+		}
+	}
+	return ""
+}
+
+// specSlug normaliza um nome para o formato de arquivo: minúsculas, sem acentos, palavras separadas por hífen.
+// Ex.: "Specs Validate_v2" -> "specs-validate-v2"
+func specSlug(name string) string {
+	replacer := strings.NewReplacer(
+		"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+		"é", "e", "è", "e", "ê", "e", "ë", "e",
+		"í", "i", "ì", "i", "î", "i", "ï", "i",
+		"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+		"ú", "u", "ù", "u", "û", "u", "ü", "u",
+		"ç", "c", "ñ", "n",
+	)
+	var b strings.Builder
+	hyphen := false
+	for _, r := range replacer.Replace(strings.ToLower(name)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		} else {
+			hyphen = true
+		}
+	}
+	return b.String()
+}
+
+// renumberTitle atualiza a numeração do título principal ("# 03 - Nome") quando a spec é renumerada
+func renumberTitle(lines []string, frontmatterEnd int, oldBase string, newBase string, scheme numbering.Scheme) {
+	oldNumber, _ := scheme.Loose(strings.TrimSuffix(oldBase, ".spec.md"))
+	newNumber, _ := scheme.Loose(strings.TrimSuffix(newBase, ".spec.md"))
+	if oldNumber == nil || oldNumber.Equal(newNumber) {
+		return
+	}
+
+	for i := frontmatterEnd; i < len(lines); i++ {
+		if !strings.HasPrefix(lines[i], "# ") {
+			continue
+		}
+		match := titleNumberRegex.FindStringSubmatch(lines[i])
+		if match != nil {
+			if n, _ := scheme.Loose(match[2]); n.Equal(oldNumber) {
+				digits := strings.TrimPrefix(scheme.Format(newNumber), scheme.Prefix)
+				lines[i] = match[1] + digits + lines[i][len(match[0]):]
+			}
+		}
+		return
+	}
+}
+
+// rewriteLinks reescreve links markdown para specs renomeadas, preservando fragmentos (exceto a
+// âncora do título principal, que acompanha a renumeração). Se o próprio arquivo muda de diretório
+// (to), todos os seus links relativos são recalculados. O texto do link também é atualizado quando
+// repete o caminho ou o nome do arquivo.
+func rewriteLinks(file string, to string, lines []string, renames map[string]string, anchors map[string][2]string) {
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		code := inlineCodeRegex.FindAllStringIndex(line, -1)
+		matches := markdownLinkRegex.FindAllStringSubmatchIndex(line, -1)
+		for j := len(matches) - 1; j >= 0; j-- {
+			m := matches[j]
+			if insideCode(m[0], code) {
+				continue
+			}
+			raw := line[m[4]:m[5]]
+			oldPath, newRaw, ok := renamedLink(file, to, raw, renames, anchors)
+			if !ok {
+				continue
+			}
+			newPath, _, _ := strings.Cut(newRaw, "#")
+			newPath, _, _ = strings.Cut(newPath, "?")
+			text := renamedLinkText(line[m[2]:m[3]], oldPath, newPath)
+			line = line[:m[2]] + text + line[m[3]:m[4]] + newRaw + line[m[5]:]
+		}
+		lines[i] = line
+	}
+}
+
+// renamedLink retorna o caminho original e o novo destino de um link cujo alvo foi renomeado
+// (ou cujo arquivo de origem mudou de diretório)
+func renamedLink(file string, to string, raw string, renames map[string]string, anchors map[string][2]string) (string, string, bool) {
+	if raw == "" || schemeRegex.MatchString(raw) || strings.HasPrefix(raw, "/") {
+		return "", "", false
+	}
+	linkPath, fragment, hasFragment := strings.Cut(raw, "#")
+	linkPath, query, hasQuery := strings.Cut(linkPath, "?")
+	if linkPath == "" {
+		// Âncora no próprio arquivo para o título renumerado
+		if anchor, ok := anchors[file]; ok && hasFragment && strings.EqualFold(fragment, anchor[0]) {
+			return "", "#" + anchor[1], true
+		}
+		return "", "", false
+	}
+	decoded := linkPath
+	if d, err := url.PathUnescape(linkPath); err == nil {
+		decoded = d
+	}
+
+	current := filepath.Clean(resolveLink(file, decoded))
+	target, ok := renames[current]
+	if !ok {
+		if filepath.Dir(file) == filepath.Dir(to) {
+			return "", "", false
+		}
+		target = current
+	}
+	rel, err := filepath.Rel(filepath.Dir(to), target)
+	if err != nil {
+		return "", "", false
+	}
+	if anchor, ok := anchors[current]; ok && strings.EqualFold(fragment, anchor[0]) {
+		fragment = anchor[1]
+	}
+
+	newRaw := filepath.ToSlash(rel)
+	if strings.HasPrefix(linkPath, "./") {
+		newRaw = "./" + newRaw
+	}
+	if hasQuery {
+		newRaw += "?" + query
+	}
+	if hasFragment {
+		newRaw += "#" + fragment
+	}
+	return linkPath, newRaw, true
+}
+
+// renamedLinkText atualiza o texto do link quando ele repete o caminho, o arquivo ou o nome da spec
+func renamedLinkText(text string, oldPath string, newPath string) string {
+	oldBase, newBase := path.Base(oldPath), path.Base(newPath)
+	switch text {
+	case oldPath:
+		return newPath
+	case oldBase:
+		return newBase
+	case strings.TrimSuffix(oldBase, ".spec.md"):
+		return strings.TrimSuffix(newBase, ".spec.md")
+	}
+	return text
+}
+
+// insideCode indica se a posição está dentro de um trecho de código inline
+func insideCode(pos int, code [][]int) bool {
+	for _, c := range code {
+		if pos >= c[0] && pos < c[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencyRefs mapeia referências de depends_on (nome completo, nome sem numeração e numeração,
+// como em `specs graph`) de specs renomeadas para as novas referências
+type dependencyRefs struct {
+	slugs     map[string]string
+	names     map[string]string
+	qualified map[string]string // Numeração qualificada pelo namespace (api/03), única no namespace
+	numbers   map[string]string // Numeração única entre todos os namespaces
+}
+
+func newDependencyRefs(basePath string, files []string, renames map[string]string, scheme numbering.Scheme) *dependencyRefs {
+	refs := &dependencyRefs{
+		slugs:     make(map[string]string),
+		names:     make(map[string]string),
+		qualified: make(map[string]string),
+		numbers:   make(map[string]string),
+	}
+	nameCount := make(map[string]int)
+	numberCount := make(map[string]int)
+	qualifiedCount := make(map[string]int)
+
+	for _, file := range files {
+		if number, name, ok := scheme.Reference(strings.TrimSuffix(filepath.Base(file), ".spec.md")); ok {
+			numberCount[number]++
+			nameCount[name]++
+			qualifiedCount[numbering.Qualify(scheme.Namespace(relativeTo(basePath, file)), number)]++
+		}
+	}
+
+	for oldFile, newFile := range renames {
+		oldSlug := strings.TrimSuffix(filepath.Base(oldFile), ".spec.md")
+		newSlug := strings.TrimSuffix(filepath.Base(newFile), ".spec.md")
+		refs.slugs[oldSlug] = newSlug
+
+		oldNumber, oldName, ok := scheme.Reference(oldSlug)
+		newNumber, newName, _ := scheme.Reference(newSlug)
+		if !ok {
+			continue
+		}
+		if oldNumber != newNumber {
+			key := numbering.Qualify(scheme.Namespace(relativeTo(basePath, oldFile)), oldNumber)
+			if qualifiedCount[key] == 1 {
+				refs.qualified[key] = newNumber
+			}
+			if numberCount[oldNumber] == 1 {
+				refs.numbers[oldNumber] = newNumber
+			}
+		}
+		if oldName != newName && nameCount[oldName] == 1 {
+			refs.names[oldName] = newName
+		}
+	}
+
+	return refs
+}
+
+// lookup retorna a nova referência. Uma numeração é procurada primeiro no namespace indicado
+// pelo diretório da referência (api/03) ou, sem diretório, no namespace da spec que a declara
+func (r *dependencyRefs) lookup(dir string, key string, namespace string) (string, bool) {
+	if updated, ok := r.slugs[key]; ok {
+		return updated, true
+	}
+	if updated, ok := r.names[key]; ok {
+		return updated, true
+	}
+	if dir != "" {
+		namespace = numbering.CleanNamespace(dir)
+	}
+	if updated, ok := r.qualified[numbering.Qualify(namespace, key)]; ok {
+		return updated, true
+	}
+	updated, ok := r.numbers[key]
+	return updated, ok
+}
+
+// rewriteDependsOn atualiza entradas de depends_on (listas inline ou em bloco) que referenciam specs renomeadas
+func rewriteDependsOn(lines []string, meta *metadata.Metadata, refs *dependencyRefs, namespace string) {
+	start := meta.Lines["depends_on"]
+	if start == 0 {
+		return
+	}
+
+	replace := func(ref string) string {
+		dir, base := "", ref
+		if idx := strings.LastIndex(ref, "/"); idx >= 0 {
+			dir, base = ref[:idx+1], ref[idx+1:]
+		}
+		key := strings.TrimSuffix(base, ".spec.md")
+		if updated, ok := refs.lookup(dir, key, namespace); ok {
+			return dir + updated + base[len(key):]
+		}
+		return ref
+	}
+
+	for n := start; n < meta.EndLine && n <= len(lines); n++ {
+		line := lines[n-1]
+		if n == start {
+			idx := strings.Index(line, ":")
+			lines[n-1] = line[:idx+1] + dependencyRefRegex.ReplaceAllStringFunc(line[idx+1:], replace)
+			continue
+		}
+		if frontmatterKeyRegex.MatchString(line) {
+			return
+		}
+		lines[n-1] = dependencyRefRegex.ReplaceAllStringFunc(line, replace)
+	}
+}
+
+// addAlias registra o nome anterior da spec em aliases no frontmatter (criando-o, se necessário).
+// Linhas inseridas são anexadas a uma linha existente para preservar a numeração das alterações.
+func addAlias(lines []string, meta *metadata.Metadata, alias string) {
+	for _, existing := range meta.Aliases {
+		if existing == alias {
+			return
+		}
+	}
+
+	line := meta.Lines["aliases"]
+	switch {
+	case meta.EndLine == 0:
+		lines[0] = "---\naliases: [" + alias + "]\n---\n" + lines[0]
+	case line == 0:
+		lines[meta.EndLine-1] = "aliases: [" + alias + "]\n" + lines[meta.EndLine-1]
+	default:
+		key, value, _ := strings.Cut(lines[line-1], ":")
+		value = strings.TrimSpace(value)
+		switch {
+		case value == "":
+			lines[line-1] += "\n  - " + alias
+		case strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]"):
+			if inner := strings.TrimSpace(value[1 : len(value)-1]); inner != "" {
+				lines[line-1] = key + ": [" + inner + ", " + alias + "]"
+			} else {
+				lines[line-1] = key + ": [" + alias + "]"
+			}
+		default:
+			lines[line-1] = key + ": [" + value + ", " + alias + "]"
+		}
+	}
+}
+
+// applyWrites grava as correções em etapas: conteúdo novo em arquivos temporários, troca pelos
+// destinos e remoção dos nomes antigos. Se a troca falhar, os arquivos originais são restaurados.
+func (s *Service) applyWrites(writes []fixWrite) error {
+	for i, w := range writes {
+		if err := s.fs.MkdirAll(filepath.Dir(w.to), 0755); err != nil {
+			return fmt.Errorf("falha ao criar diretório de %s: %w", w.to, err)
+		}
+		if err := s.fs.WriteFile(w.to+fixTempSuffix, w.content, w.perm); err != nil {
+			for _, staged := range writes[:i+1] {
+				s.fs.Remove(staged.to + fixTempSuffix)
+			}
+			return fmt.Errorf("falha ao gravar %s: %w", w.to, err)
+		}
+	}
+
+	for i, w := range writes {
+		if err := s.fs.Rename(w.to+fixTempSuffix, w.to); err != nil {
+			s.rollbackWrites(writes, i)
+			return fmt.Errorf("falha ao gravar %s (alterações desfeitas): %w", w.to, err)
+		}
+	}
+
+	targets := make(map[string]bool)
+	for _, w := range writes {
+		targets[w.to] = true
+	}
+	for _, w := range writes {
+		if w.from != w.to && !targets[w.from] {
+			if err := s.fs.Remove(w.from); err != nil {
+				return fmt.Errorf("falha ao remover %s após renomear para %s: %w", w.from, w.to, err)
+			}
+		}
+	}
+	return nil
+}
+
+// rollbackWrites restaura o conteúdo original de todos os arquivos, remove destinos novos já
+// gravados e descarta os temporários restantes
+func (s *Service) rollbackWrites(writes []fixWrite, committed int) {
+	sources := make(map[string]bool)
+	for _, w := range writes {
+		sources[w.from] = true
+	}
+	for i, w := range writes {
+		if i < committed && !sources[w.to] {
+			s.fs.Remove(w.to)
+		}
+		if i >= committed {
+			s.fs.Remove(w.to + fixTempSuffix)
+		}
+		s.fs.WriteFile(w.from, w.original, w.perm)
+	}
+}
+
+// relativeTo retorna o caminho relativo ao diretório de specs (ou o nome do arquivo)
+func relativeTo(basePath string, file string) string {
+	relPath, _ := filepath.Rel(basePath, file)
+	if relPath == "" || relPath == "." {
+		relPath = filepath.Base(file)
+	}
+	return relPath
+}
@@ -0,0 +1,348 @@
+package checker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/numbering"
+)
+
+func writeFixSpecs(t *testing.T, files map[string]string) string {
+	t.Helper()
+	fs := adapters.NewFileSystem()
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	for name, content := range files {
+		path := filepath.Join(specsDir, name)
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("falha ao criar diretório: %v", err)
+		}
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+	return specsDir
+}
+
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			rel, _ := filepath.Rel(dir, path)
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("falha ao listar %s: %v", dir, err)
+	}
+	sort.Strings(files)
+	return files
+}
+
+func TestService_Fix(t *testing.T) {
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-a.spec.md":           "# 01 - A\n\nVeja [03-c.spec.md](03-c.spec.md#dados) e [B](01-b.spec.md).\n`[código](03-c.spec.md)`\n",
+		"01-b.spec.md":           "---\ndepends_on: [03]\n---\n# 01 - B\n",
+		"03-c.spec.md":           "---\ndepends_on:\n  - e.spec.md\nstatus: active\n---\n# 03 - C\n## Dados\n",
+		"api/7_Nova API.spec.md": "# 7 - Nova API\n[C](../03-c.spec.md)\n",
+		"e.spec.md":              "# Especificação E\n",
+		"checklist.md":           "- [ ] [C](03-c.spec.md)\n",
+	})
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	// Simulação: planeja sem alterar arquivos
+	before := listFiles(t, specsDir)
+	result, err := service.Fix(FixOptions{Path: specsDir, DryRun: true})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if result.Applied || strings.Join(listFiles(t, specsDir), " ") != strings.Join(before, " ") {
+		t.Fatalf("--dry-run não deveria alterar arquivos")
+	}
+
+	var renames []string
+	for _, r := range result.Renames {
+		renames = append(renames, r.From+" → "+r.To)
+	}
+	expected := []string{
+		"01-b.spec.md → 02-b.spec.md",
+		"api/7_Nova API.spec.md → api/04-nova-api.spec.md",
+		"e.spec.md → 05-e.spec.md",
+	}
+	if strings.Join(renames, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("renomeações inesperadas:\n%s", strings.Join(renames, "\n"))
+	}
+
+	// Aplicar
+	result, err = service.Fix(FixOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !result.Applied {
+		t.Fatal("correções deveriam ter sido aplicadas")
+	}
+
+	files := strings.Join(listFiles(t, specsDir), " ")
+	if files != "01-a.spec.md 02-b.spec.md 03-c.spec.md 05-e.spec.md api/04-nova-api.spec.md checklist.md" {
+		t.Errorf("arquivos inesperados após correção: %s", files)
+	}
+
+	read := func(name string) string {
+		data, err := fs.ReadFile(filepath.Join(specsDir, name))
+		if err != nil {
+			t.Fatalf("falha ao ler %s: %v", name, err)
+		}
+		return string(data)
+	}
+	if content := read("01-a.spec.md"); !strings.Contains(content, "[B](02-b.spec.md)") {
+		t.Errorf("link para spec renomeada não foi reescrito:\n%s", content)
+	}
+	if content := read("01-a.spec.md"); !strings.Contains(content, "[03-c.spec.md](03-c.spec.md#dados)") || !strings.Contains(content, "`[código](03-c.spec.md)`") {
+		t.Errorf("links não renomeados ou em código não deveriam mudar:\n%s", content)
+	}
+	if content := read("02-b.spec.md"); !strings.Contains(content, "# 02 - B") || !strings.Contains(content, "depends_on: [03]") {
+		t.Errorf("título ou depends_on inesperado:\n%s", content)
+	}
+	if content := read("03-c.spec.md"); !strings.Contains(content, "  - 05-e.spec.md\n") {
+		t.Errorf("depends_on em bloco não foi reescrito:\n%s", content)
+	}
+	if content := read("api/04-nova-api.spec.md"); !strings.HasPrefix(content, "# 04 - Nova API") {
+		t.Errorf("título não foi renumerado:\n%s", content)
+	}
+
+	// Após a correção, não restam problemas de numeração, formato ou links
+	check, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	for _, p := range check.Problems {
+		t.Errorf("problema após correção: %s %s: %s", p.Category, p.File, p.Message)
+	}
+
+	// Segunda execução não tem o que corrigir
+	result, err = service.Fix(FixOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !result.Empty() {
+		t.Errorf("esperado nenhuma correção, obtido %+v", result)
+	}
+}
+
+func TestService_Fix_RenumberChain(t *testing.T) {
+	specsDir := writeFixSpecs(t, map[string]string{
+		"02-a.spec.md": "# 02 - A\n[B](03-b.spec.md)\n",
+		"03-b.spec.md": "# 03 - B\n[A](02-a.spec.md)\n",
+	})
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	if _, err := service.Fix(FixOptions{Path: specsDir}); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	// Numeração parte do menor número existente, então apenas o gap é fechado
+	a, _ := fs.ReadFile(filepath.Join(specsDir, "02-a.spec.md"))
+	if string(a) != "# 02 - A\n[B](03-b.spec.md)\n" {
+		t.Errorf("spec sem gap não deveria mudar: %q", a)
+	}
+
+	// 01-b -> 02-b, cujo nome atual pertence à spec renomeada para 03-b
+	specsDir = writeFixSpecs(t, map[string]string{
+		"01-a.spec.md": "# 01 - A\n",
+		"01-b.spec.md": "# 01 - B\n[próxima](02-b.spec.md)\n",
+		"02-b.spec.md": "# 02 - B2\n[anterior](01-b.spec.md)\n",
+	})
+	if _, err := service.Fix(FixOptions{Path: specsDir}); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if files := strings.Join(listFiles(t, specsDir), " "); files != "01-a.spec.md 02-b.spec.md 03-b.spec.md" {
+		t.Fatalf("arquivos inesperados: %s", files)
+	}
+	b, _ := fs.ReadFile(filepath.Join(specsDir, "02-b.spec.md"))
+	b2, _ := fs.ReadFile(filepath.Join(specsDir, "03-b.spec.md"))
+	if string(b) != "# 02 - B\n[próxima](03-b.spec.md)\n" || string(b2) != "# 03 - B2\n[anterior](02-b.spec.md)\n" {
+		t.Errorf("conteúdo inesperado após renomeação em cadeia:\n%s\n%s", b, b2)
+	}
+}
+
+func TestService_Fix_KeepsSuppressed(t *testing.T) {
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-a.spec.md":     "# 01 - A\n",
+		"02-b.spec.md":     "# 02 - B\n<!-- specs-disable: numbering -->\n",
+		"template.spec.md": "# Template\n<!-- specs-disable: format -->\n",
+		"04-c.spec.md":     "# 04 - C\n",
+		"05-d.spec.md":     "# 05 - D\n",
+	})
+	service := NewService(adapters.NewFileSystem())
+
+	result, err := service.Fix(FixOptions{Path: specsDir, DryRun: true})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if strings.Join(result.Kept, " ") != "02-b.spec.md template.spec.md" {
+		t.Errorf("specs mantidas inesperadas: %v", result.Kept)
+	}
+	// 02 fica reservado: 04 -> 03 e 05 -> 04
+	var renames []string
+	for _, r := range result.Renames {
+		renames = append(renames, r.From+" → "+r.To)
+	}
+	if strings.Join(renames, ", ") != "04-c.spec.md → 03-c.spec.md, 05-d.spec.md → 04-d.spec.md" {
+		t.Errorf("renomeações inesperadas: %v", renames)
+	}
+}
+
+func TestSpecSlug(t *testing.T) {
+	tests := map[string]string{
+		"Nova API":            "nova-api",
+		"Migração_de Dados!":  "migracao-de-dados",
+		"--specs--validate--": "specs-validate",
+		"":                    "",
+	}
+	for input, expected := range tests {
+		if got := specSlug(input); got != expected {
+			t.Errorf("specSlug(%q) = %q, esperado %q", input, got, expected)
+		}
+	}
+}
+
+func TestService_Fix_NumberingScheme(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	// Hierárquica: subníveis acompanham o pai e são renumerados a partir de 1
+	hierarchical, _ := numbering.ParseScheme("00.0")
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-a.spec.md":   "# 01 - A\n[C](03.2-c.spec.md)\n",
+		"03-b.spec.md":   "# 03 - B\n",
+		"03.2-c.spec.md": "# 03.2 - C\n",
+		"03.5-d.spec.md": "# 03.5 - D\n",
+	})
+	result, err := service.Fix(FixOptions{Path: specsDir, Numbering: hierarchical})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if files := strings.Join(listFiles(t, specsDir), " "); files != "01-a.spec.md 02-b.spec.md 02.1-c.spec.md 02.2-d.spec.md" {
+		t.Fatalf("arquivos inesperados: %s (%+v)", files, result.Renames)
+	}
+	a, _ := fs.ReadFile(filepath.Join(specsDir, "01-a.spec.md"))
+	c, _ := fs.ReadFile(filepath.Join(specsDir, "02.1-c.spec.md"))
+	if string(a) != "# 01 - A\n[C](02.1-c.spec.md)\n" || string(c) != "# 02.1 - C\n" {
+		t.Errorf("conteúdo inesperado:\n%s\n%s", a, c)
+	}
+	if check, _ := service.Check(CheckOptions{Path: specsDir, Numbering: hierarchical}); len(check.Problems) != 0 {
+		t.Errorf("problemas após correção: %+v", check.Problems)
+	}
+
+	// Prefixo: nomes fora do padrão recebem prefixo e largura do esquema
+	adr, _ := numbering.ParseScheme("ADR-0000")
+	specsDir = writeFixSpecs(t, map[string]string{
+		"ADR-0001-usar-go.spec.md": "# ADR-0001 - Usar Go\n",
+		"adr-3 Banco.spec.md":      "# ADR-3 - Banco\n",
+	})
+	result, err = service.Fix(FixOptions{Path: specsDir, Numbering: adr, DryRun: true})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(result.Renames) != 1 || result.Renames[0].To != "ADR-0002-banco.spec.md" ||
+		result.Renames[0].Reason != "numeração ADR-0003 → ADR-0002, nome fora do padrão ADR-{numero}-{nome}.spec.md" {
+		t.Errorf("renomeações inesperadas: %+v", result.Renames)
+	}
+	if len(result.Edits) != 1 || result.Edits[0].Changes[0].After != "# ADR-0002 - Banco" {
+		t.Errorf("título não foi renumerado: %+v", result.Edits)
+	}
+}
+
+func TestService_Fix_NumberingNamespaces(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-raiz.spec.md":    "---\ndepends_on: [cli/03]\n---\n# 01 - Raiz\n",
+		"api/02-a.spec.md":   "---\ndepends_on: [03]\n---\n# 02 - A\n",
+		"api/03-b.spec.md":   "# 03 - B\n",
+		"cli/01-x.spec.md":   "---\ndepends_on: [03]\n---\n# 01 - X\n",
+		"cli/03-y.spec.md":   "# 03 - Y\n",
+		"cli/sub/05.spec.md": "# 05 - Z\n",
+	})
+
+	scheme := numbering.Scheme{Namespaces: []string{"api", "cli"}}
+	if _, err := service.Fix(FixOptions{Path: specsDir, Numbering: scheme}); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	// Cada namespace é renumerado a partir da sua menor numeração
+	expected := "01-raiz.spec.md api/02-a.spec.md api/03-b.spec.md cli/01-x.spec.md cli/02-y.spec.md cli/sub/03-z.spec.md"
+	if files := strings.Join(listFiles(t, specsDir), " "); files != expected {
+		t.Fatalf("arquivos inesperados: %s", files)
+	}
+
+	// depends_on por numeração é atualizado apenas no namespace correspondente
+	for file, content := range map[string]string{
+		"01-raiz.spec.md":  "---\ndepends_on: [cli/02]\n---\n# 01 - Raiz\n",
+		"api/02-a.spec.md": "---\ndepends_on: [03]\n---\n# 02 - A\n",
+		"cli/01-x.spec.md": "---\ndepends_on: [02]\n---\n# 01 - X\n",
+	} {
+		data, _ := fs.ReadFile(filepath.Join(specsDir, file))
+		if string(data) != content {
+			t.Errorf("conteúdo inesperado em %s:\n%s", file, data)
+		}
+	}
+	if check, _ := service.Check(CheckOptions{Path: specsDir, Numbering: scheme}); len(check.Problems) != 0 {
+		t.Errorf("problemas após correção: %+v", check.Problems)
+	}
+}
+
+func TestService_Fix_RootSpecsAndTemplate(t *testing.T) {
+	// Mesmo layout de specs/ do repositório: várias specs de contexto 00-*, template e checklist
+	files := map[string]string{
+		"00-architecture.spec.md":   "# 00 - Arquitetura\n",
+		"00-global-context.spec.md": "# 00 - Contexto Global\n",
+		"00-stack.spec.md":          "# 00 - Stack\n",
+		"template-default.spec.md":  "# [NN] - [Nome]\n",
+		"checklist.md":              "- [ ] [Stack](00-stack.spec.md)\n",
+	}
+	names := []string{"version-control", "init", "specs-validate", "specs-list", "specs-check", "specs-view", "config",
+		"ci-cd-setup", "specs-update", "specs-trace", "specs-coverage", "specs-graph", "specs-mv", "specs-show"}
+	for i, name := range names {
+		files[fmt.Sprintf("%02d-%s.spec.md", i+1, name)] = fmt.Sprintf("# %02d - %s\n[Stack](00-stack.spec.md)\n", i+1, name)
+	}
+	specsDir := writeFixSpecs(t, files)
+	service := NewService(adapters.NewFileSystem())
+
+	result, err := service.Fix(FixOptions{Path: specsDir, DryRun: true})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !result.Empty() {
+		t.Errorf("layout válido não deveria ter correções: renomeações %+v, edições %+v", result.Renames, result.Edits)
+	}
+
+	// Gap nas specs numeradas é corrigido sem tocar nas specs 00-* nem no template
+	if err := os.Remove(filepath.Join(specsDir, "05-specs-check.spec.md")); err != nil {
+		t.Fatalf("falha ao remover spec: %v", err)
+	}
+	result, err = service.Fix(FixOptions{Path: specsDir, DryRun: true})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	var renames []string
+	for _, r := range result.Renames {
+		renames = append(renames, r.From+" → "+r.To)
+	}
+	if len(renames) != 9 || renames[0] != "06-specs-view.spec.md → 05-specs-view.spec.md" {
+		t.Errorf("renomeações inesperadas: %v", renames)
+	}
+	for _, r := range renames {
+		if strings.HasPrefix(r, "00-") || strings.HasPrefix(r, "template") {
+			t.Errorf("spec raiz ou template não deveria ser renomeada: %s", r)
+		}
+	}
+}
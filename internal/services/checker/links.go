@@ -0,0 +1,67 @@
+package checker
+
+import (
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	markdownLinkRegex = regexp.MustCompile(`!?\[([^\]]*)\]\(\s*<?([^)\s>]*)>?(?:\s+["'][^)]*["'])?\s*\)`)
+	inlineCodeRegex   = regexp.MustCompile("`[^`]*`")
+	schemeRegex       = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*:`)
+)
+
+// markdownLink é um link (ou imagem) markdown para um arquivo local
+type markdownLink struct {
+	Raw      string // Destino como escrito (ex.: ../api/03-foo.spec.md#dados)
+	Path     string // Caminho decodificado, sem fragmento nem query (vazio para âncoras no próprio arquivo)
+	Fragment string
+	Line     int
+}
+
+// localLinks extrai links markdown para arquivos locais. URLs com esquema (https:, mailto:),
+// caminhos absolutos e links em blocos de código ou código inline são ignorados.
+func localLinks(content string) []markdownLink {
+	var links []markdownLink
+	inFence := false
+
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		line = inlineCodeRegex.ReplaceAllString(line, "")
+		for _, match := range markdownLinkRegex.FindAllStringSubmatch(line, -1) {
+			raw := match[2]
+			if raw == "" || schemeRegex.MatchString(raw) || strings.HasPrefix(raw, "/") {
+				continue
+			}
+			path, fragment, _ := strings.Cut(raw, "#")
+			path, _, _ = strings.Cut(path, "?")
+			if decoded, err := url.PathUnescape(path); err == nil {
+				path = decoded
+			}
+			links = append(links, markdownLink{Raw: raw, Path: path, Fragment: fragment, Line: i + 1})
+		}
+	}
+
+	return links
+}
+
+// resolveLink resolve o caminho do link relativo ao diretório do arquivo que contém o link
+func resolveLink(file string, linkPath string) string {
+	return filepath.Join(filepath.Dir(file), filepath.FromSlash(linkPath))
+}
+
+// isMarkdown indica se o arquivo é markdown (e, portanto, possui âncoras de títulos)
+func isMarkdown(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".md" || ext == ".markdown"
+}
@@ -0,0 +1,82 @@
+package checker
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func TestLocalLinks(t *testing.T) {
+	content := "[a](../api/03-foo.spec.md#dados) ![](img/fluxo%20novo.png \"título\") [b](#secao)\n" +
+		"[site](https://x.io/a.md) [mail](mailto:a@b.c) [abs](/docs/a.md) `[c](codigo.md)`\n" +
+		"```\n[d](bloco.md)\n```\n"
+	links := localLinks(content)
+
+	expected := []markdownLink{
+		{Raw: "../api/03-foo.spec.md#dados", Path: "../api/03-foo.spec.md", Fragment: "dados", Line: 1},
+		{Raw: "img/fluxo%20novo.png", Path: "img/fluxo novo.png", Line: 1},
+		{Raw: "#secao", Fragment: "secao", Line: 1},
+	}
+	if len(links) != len(expected) {
+		t.Fatalf("esperados %d links, obtido %+v", len(expected), links)
+	}
+	for i := range expected {
+		if links[i] != expected[i] {
+			t.Errorf("link %d: esperado %+v, obtido %+v", i, expected[i], links[i])
+		}
+	}
+}
+
+func TestService_Check_RelativeLinks(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	root := t.TempDir()
+	specsDir := filepath.Join(root, "specs")
+	files := map[string]string{
+		filepath.Join(specsDir, "api", "03-foo.spec.md"):   "# 03 Foo\n## Dados\n",
+		filepath.Join(specsDir, "web", "04-bar.spec.md"):   "# 04 Bar\n",
+		filepath.Join(specsDir, "web", "img", "fluxo.png"): "png",
+		filepath.Join(specsDir, "checklist.md"):            "# Checklist\n## Revisão\n",
+		filepath.Join(specsDir, "web", "05-links.spec.md"): "# 05 Links\n" +
+			"[ok](../api/03-foo.spec.md#dados) [ok](04-bar.spec.md) [ok](img/fluxo.png) [ok](../checklist.md#revisão)\n" +
+			"[errado](03-foo.spec.md) [imagem](img/nada.png) [ancora](../checklist.md#nada)\n",
+	}
+	for path, content := range files {
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("falha ao criar diretório: %v", err)
+		}
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", path, err)
+		}
+	}
+
+	result, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	expected := []string{
+		"Link para '03-foo.spec.md' não encontrado (você quis dizer '../api/03-foo.spec.md'?)",
+		"Link para 'img/nada.png' não encontrado",
+		"Âncora '#nada' não encontrada em '../checklist.md'",
+	}
+	var messages []string
+	for _, p := range result.Problems {
+		if p.Category == "Links" {
+			messages = append(messages, p.Message)
+			if p.File != filepath.Join("web", "05-links.spec.md") || p.Line != 3 {
+				t.Errorf("localização inesperada: %s:%d", p.File, p.Line)
+			}
+		}
+	}
+	if len(messages) != len(expected) {
+		t.Fatalf("esperados %d problemas de links, obtido %v", len(expected), messages)
+	}
+	for i := range expected {
+		if messages[i] != expected[i] {
+			t.Errorf("esperado %q, obtido %q", expected[i], messages[i])
+		}
+	}
+}
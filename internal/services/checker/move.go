@@ -0,0 +1,197 @@
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
+)
+
+// MoveOptions contém opções de `specs mv`
+type MoveOptions struct {
+	Path   string // Diretório de specs
+	Spec   string // Spec a mover: caminho do arquivo, nome completo, nome sem numeração ou numeração
+	Target string // Nova numeração (07), novo nome (nova-api), ambos (07-nova-api) ou caminho relativo (api/07-auth)
+	Alias  bool   // Registra o nome anterior em aliases no frontmatter da spec
+	DryRun bool   // Apenas planeja as alterações, sem gravar arquivos
+
+	Numbering numbering.Scheme // Esquema de numeração (valor zero = padrão de dois dígitos)
+}
+
+// Move renomeia (ou renumera, ou move de diretório) uma spec e atualiza todos os links para ela,
+// inclusive com âncoras, além de depends_on e do número do título principal
+func (s *Service) Move(opts MoveOptions) (*FixResult, error) {
+	basePath, err := s.resolvePath(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	specFiles, err := s.findSpecFiles(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
+	}
+	sort.Strings(specFiles)
+
+	source, err := s.findSpec(basePath, specFiles, opts.Spec, opts.Numbering)
+	if err != nil {
+		return nil, err
+	}
+	target, err := moveTarget(basePath, source, opts.Target, opts.Numbering)
+	if err != nil {
+		return nil, err
+	}
+	if target == source {
+		return nil, fmt.Errorf("destino igual à origem: %s", relativeTo(basePath, source))
+	}
+	if s.fs.Exists(target) && !strings.EqualFold(target, source) {
+		return nil, fmt.Errorf("destino já existe: %s", relativeTo(basePath, target))
+	}
+	if rel, err := filepath.Rel(basePath, target); err != nil || strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("destino fora do diretório de specs: %s", opts.Target)
+	}
+
+	result := &FixResult{}
+	result.Renames = append(result.Renames, Rename{
+		From: relativeTo(basePath, source),
+		To:   relativeTo(basePath, target),
+	})
+
+	// A nova numeração não é exclusiva no namespace: `specs check` reportará a duplicata
+	number := opts.Numbering.Number(filepath.Base(target))
+	namespace := opts.Numbering.Namespace(relativeTo(basePath, target))
+	for _, file := range specFiles {
+		if file != source && opts.Numbering.Number(filepath.Base(file)).Equal(number) &&
+			opts.Numbering.Namespace(relativeTo(basePath, file)) == namespace {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("numeração %s também usada por %s", opts.Numbering.Format(number), relativeTo(basePath, file)))
+		}
+	}
+
+	var aliases map[string]string
+	if opts.Alias {
+		aliases = map[string]string{source: strings.TrimSuffix(filepath.Base(source), ".spec.md")}
+	}
+	renames := map[string]string{source: target}
+	if err := s.rewriteReferences(basePath, specFiles, opts.Numbering, renames, aliases, opts.DryRun, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ErrSpecNotFound indica que nenhuma spec corresponde à referência informada
+var ErrSpecNotFound = errors.New("spec não encontrada")
+
+// FindSpec localiza, no diretório de specs, a spec pelo caminho do arquivo ou por referência
+// (nome completo, nome sem numeração, numeração ou alias). Retorna o caminho do arquivo; se
+// nenhuma spec corresponder, o erro satisfaz errors.Is(err, ErrSpecNotFound).
+func (s *Service) FindSpec(path string, ref string, scheme numbering.Scheme) (string, error) {
+	basePath, err := s.resolvePath(path)
+	if err != nil {
+		return "", err
+	}
+	specFiles, err := s.findSpecFiles(basePath)
+	if err != nil {
+		return "", fmt.Errorf("falha ao listar arquivos: %w", err)
+	}
+	sort.Strings(specFiles)
+	return s.findSpec(basePath, specFiles, ref, scheme)
+}
+
+// findSpec localiza a spec pelo caminho do arquivo ou por referência (nome completo, nome sem
+// numeração, numeração ou alias), como em depends_on
+func (s *Service) findSpec(basePath string, specFiles []string, ref string, scheme numbering.Scheme) (string, error) {
+	if strings.HasSuffix(ref, ".spec.md") && s.fs.Exists(ref) {
+		abs, _ := filepath.Abs(ref)
+		for _, file := range specFiles {
+			if fileAbs, _ := filepath.Abs(file); fileAbs == abs {
+				return file, nil
+			}
+		}
+		return "", fmt.Errorf("spec fora do diretório de specs: %s", ref)
+	}
+
+	key := strings.TrimSuffix(filepath.ToSlash(ref), ".spec.md")
+	var bySlug, byName, byNumber, byAlias []string
+	for _, file := range specFiles {
+		slug := strings.TrimSuffix(filepath.Base(file), ".spec.md")
+		rel := strings.TrimSuffix(filepath.ToSlash(relativeTo(basePath, file)), ".spec.md")
+		number, name, _ := scheme.Reference(slug)
+		switch {
+		case key == rel || key == slug:
+			bySlug = append(bySlug, file)
+		case key == name:
+			byName = append(byName, file)
+		case key == number || key == numbering.Qualify(scheme.Namespace(rel), number):
+			byNumber = append(byNumber, file)
+		}
+		if data, err := s.fs.ReadFile(file); err == nil {
+			for _, alias := range metadata.Parse(string(data)).Aliases {
+				if strings.TrimSuffix(alias, ".spec.md") == key {
+					byAlias = append(byAlias, file)
+				}
+			}
+		}
+	}
+
+	for _, candidates := range [][]string{bySlug, byName, byNumber, byAlias} {
+		switch len(candidates) {
+		case 0:
+			continue
+		case 1:
+			return candidates[0], nil
+		default:
+			var names []string
+			for _, c := range candidates {
+				names = append(names, relativeTo(basePath, c))
+			}
+			return "", fmt.Errorf("spec ambígua: %s corresponde a %s", ref, strings.Join(names, ", "))
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrSpecNotFound, ref)
+}
+
+// moveTarget calcula o novo caminho da spec. A parte omitida (numeração ou nome) é mantida e,
+// sem diretório, a spec permanece no diretório atual. Terminado em "/", apenas muda de diretório.
+func moveTarget(basePath string, source string, target string, scheme numbering.Scheme) (string, error) {
+	target = strings.TrimSuffix(filepath.ToSlash(strings.TrimSpace(target)), ".spec.md")
+	dir := filepath.Dir(source)
+	hasDir := false
+	if idx := strings.LastIndex(target, "/"); idx >= 0 {
+		dir = filepath.Join(basePath, filepath.FromSlash(target[:idx]))
+		target = target[idx+1:]
+		hasDir = true
+	}
+	if target == "" && !hasDir {
+		return "", fmt.Errorf("destino inválido: informe a nova numeração ou o novo nome")
+	}
+
+	number, name, ok := scheme.Split(filepath.Base(source))
+	if !ok {
+		number, name = scheme.Loose(strings.TrimSuffix(filepath.Base(source), ".spec.md"))
+		name = specSlug(name)
+	}
+	if target != "" {
+		// Numeração (com ou sem prefixo), opcionalmente seguida de -{nome}; caso contrário, apenas o nome
+		targetNumber, rest := scheme.Loose(target)
+		separated := strings.HasSuffix(strings.TrimSuffix(target, rest), "-") || rest == ""
+		switch {
+		case targetNumber != nil && rest == "":
+			number = targetNumber
+		case targetNumber != nil && separated:
+			number, name = targetNumber, specSlug(rest)
+		default:
+			name = specSlug(target)
+		}
+	}
+
+	if number == nil {
+		return "", fmt.Errorf("a spec não possui numeração: informe o destino no formato %s-{nome}", scheme.Placeholder())
+	}
+	if name == "" {
+		return "", fmt.Errorf("destino inválido: %s", target)
+	}
+	return filepath.Join(dir, scheme.FileName(number, name)), nil
+}
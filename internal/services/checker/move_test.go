@@ -0,0 +1,101 @@
+package checker
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func TestService_Move(t *testing.T) {
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-a.spec.md":     "# 01 - A\n\nVeja [B](02-b.spec.md#02---b) e [dados](02-b.spec.md#dados).\n",
+		"02-b.spec.md":     "---\nstatus: active\n---\n# 02 - B\n\n[topo](#02---b) [A](01-a.spec.md)\n## Dados\n",
+		"api/03-c.spec.md": "---\ndepends_on: [02-b]\n---\n# 03 - C\n[B](../02-b.spec.md)\n",
+	})
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	result, err := service.Move(MoveOptions{Path: specsDir, Spec: "b", Target: "api/05", Alias: true})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !result.Applied || len(result.Renames) != 1 || result.Renames[0].To != filepath.Join("api", "05-b.spec.md") {
+		t.Fatalf("resultado inesperado: %+v", result)
+	}
+	if files := strings.Join(listFiles(t, specsDir), " "); files != "01-a.spec.md api/03-c.spec.md api/05-b.spec.md" {
+		t.Fatalf("arquivos inesperados: %s", files)
+	}
+
+	expected := map[string]string{
+		"01-a.spec.md":     "# 01 - A\n\nVeja [B](api/05-b.spec.md#05---b) e [dados](api/05-b.spec.md#dados).\n",
+		"api/05-b.spec.md": "---\nstatus: active\naliases: [02-b]\n---\n# 05 - B\n\n[topo](#05---b) [A](../01-a.spec.md)\n## Dados\n",
+		"api/03-c.spec.md": "---\ndepends_on: [05-b]\n---\n# 03 - C\n[B](05-b.spec.md)\n",
+	}
+	for name, content := range expected {
+		data, err := fs.ReadFile(filepath.Join(specsDir, name))
+		if err != nil {
+			t.Fatalf("falha ao ler %s: %v", name, err)
+		}
+		if string(data) != content {
+			t.Errorf("%s inesperado:\n%s", name, data)
+		}
+	}
+
+	// Links e âncoras continuam válidos
+	check, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	for _, p := range check.Problems {
+		if p.Category == "Links" {
+			t.Errorf("link quebrado após mover: %s: %s", p.File, p.Message)
+		}
+	}
+
+	// O nome anterior continua resolvendo para a spec
+	result, err = service.Move(MoveOptions{Path: specsDir, Spec: "02-b", Target: "06", DryRun: true})
+	if err != nil {
+		t.Fatalf("alias não resolvido: %v", err)
+	}
+	if result.Applied || result.Renames[0].To != filepath.Join("api", "06-b.spec.md") {
+		t.Errorf("resultado inesperado: %+v", result)
+	}
+}
+
+func TestService_Move_Errors(t *testing.T) {
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-a.spec.md":     "# 01 - A\n",
+		"02-b.spec.md":     "# 02 - B\n",
+		"api/02-c.spec.md": "# 02 - C\n",
+	})
+	service := NewService(adapters.NewFileSystem())
+
+	tests := []struct {
+		spec     string
+		target   string
+		expected string
+	}{
+		{"09", "10", "spec não encontrada"},
+		{"02", "10", "spec ambígua"},
+		{"a", "02-b", "destino já existe"},
+		{"a", "01", "destino igual à origem"},
+		{"a", "../01-a", "fora do diretório de specs"},
+	}
+	for _, tt := range tests {
+		_, err := service.Move(MoveOptions{Path: specsDir, Spec: tt.spec, Target: tt.target})
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("mv %s %s: esperado erro %q, obtido %v", tt.spec, tt.target, tt.expected, err)
+		}
+	}
+
+	// Numeração repetida é permitida, com aviso
+	result, err := service.Move(MoveOptions{Path: specsDir, Spec: "a", Target: "02", DryRun: true})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(result.Warnings) != 2 {
+		t.Errorf("esperados 2 avisos de numeração repetida, obtido %v", result.Warnings)
+	}
+}
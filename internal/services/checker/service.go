@@ -2,37 +2,63 @@ package checker
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
-	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/baseline"
+	"github.com/dreibox/specs/internal/services/graph"
+	"github.com/dreibox/specs/internal/services/linkchecker"
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
+	"github.com/dreibox/specs/internal/services/suppression"
 )
 
 // Service gerencia verificação de consistência estrutural
 type Service struct {
-	fs adapters.FileSystem
+	fs    adapters.FileSystem
+	graph *graph.Service
+	links *linkchecker.Service
 }
 
 // NewService cria uma nova instância do Service
 func NewService(fs adapters.FileSystem) *Service {
-	return &Service{fs: fs}
+	return &Service{
+		fs:    fs,
+		graph: graph.NewService(fs),
+		links: linkchecker.NewService(fs),
+	}
 }
 
 // CheckOptions contém opções para verificação
 type CheckOptions struct {
-	Path string
+	Path  string
+	Files    []string           // Se informado, reporta apenas problemas destes arquivos (links continuam resolvidos contra a árvore completa)
+	Baseline *baseline.Baseline // Se informado, problemas registrados no baseline não são reportados
+	External *linkchecker.Options // Se informado, verifica também links externos (http/https)
+	Numbering numbering.Scheme     // Esquema de numeração (valor zero = padrão de dois dígitos)
+	Orphans   *OrphanOptions       // Se informado, detecta specs não referenciadas (inalcançáveis)
+	TitleSlug string               // Severidade ("error", "warning") de nomes que não correspondem ao título; vazio = não verifica
+}
+
+// OrphanOptions configura a detecção de specs não referenciadas
+type OrphanOptions struct {
+	Severity string   // "error" ou "warning"
+	Roots    []string // Specs raiz (nome ou caminho relativo, sem .spec.md); vazio = specs 00-*
 }
 
 // Problem representa um problema encontrado
 type Problem struct {
-	Category string // "Numeração", "Links", "Formato", etc.
+	Category string // "Numeração", "Links", "Formato", "Dependências", etc.
 	Severity string // "error", "warning"
 	File     string
 	Line     int
 	Message  string
+	Key      string // Identificador estável para o baseline; vazio usa Message
 }
 
 // CheckResult contém resultado da verificação
@@ -40,68 +66,276 @@ type CheckResult struct {
 	TotalSpecs int
 	Problems   []Problem
 	Summary    map[string]int // categoria -> quantidade
+	Suppressed int            // Problemas suprimidos pelo baseline
+	External   *ExternalSummary // Preenchido apenas quando links externos são verificados
+}
+
+// ExternalSummary resume a verificação de links externos
+type ExternalSummary struct {
+	URLs    int // URLs distintas encontradas
+	Checked int // Verificadas pela rede
+	Cached  int // Válidas pelo cache
+	Skipped int // Não verificadas (permissão/bloqueio ou offline)
 }
 
 // Check verifica consistência estrutural de specs
 func (s *Service) Check(opts CheckOptions) (*CheckResult, error) {
-	// Determinar caminho
-	path := opts.Path
+	path, err := s.resolvePath(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Listar todos os arquivos .spec.md
+	specFiles, err := s.findSpecFiles(path)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
+	}
+
+	result := &CheckResult{
+		TotalSpecs: len(specFiles),
+		Problems:   []Problem{},
+		Summary:    make(map[string]int),
+	}
+
+	// Construir mapeamento de specs (número, qualificado pelo namespace -> arquivos)
+	specMap := s.buildSpecMap(specFiles, path, opts.Numbering)
+
+	// Carregar diretivas de supressão inline de cada spec
+	suppressions := s.loadSuppressions(specFiles)
+
+	// Validar numeração
+	s.checkNumbering(specFiles, path, result, specMap, opts.Numbering)
+
+	// Validar formato de nomes
+	s.checkFileNameFormat(specFiles, path, result, opts.Numbering)
+
+	// Validar links (inclui specs referenciadas que não existem, resolvidas a partir da spec de origem)
+	s.checkLinks(specFiles, path, result)
+
+	// Validar títulos (numeração, frontmatter, duplicatas e nome do arquivo)
+	s.checkTitles(specFiles, path, result, opts.Numbering, opts.TitleSlug)
+
+	// Grafo de dependências (links e depends_on), compartilhado pelas verificações abaixo
+	g, err := s.graph.Build(graph.GraphOptions{Path: path, Numbering: opts.Numbering})
+	if err != nil {
+		return nil, err
+	}
+
+	// Detectar ciclos e dependências inválidas ou obsoletas
+	s.checkDependencies(g, result)
+
+	// Detectar specs não referenciadas (opcional)
+	if opts.Orphans != nil {
+		s.checkUnreferencedSpecs(g, opts.Numbering, *opts.Orphans, result)
+	}
+
+	// Verificar links externos (opcional, acessa a rede)
+	if opts.External != nil {
+		if err := s.checkExternalLinks(specFiles, path, result, *opts.External); err != nil {
+			return nil, err
+		}
+	}
+
+	// Aplicar diretivas de supressão inline
+	s.applySuppressions(specFiles, path, result, suppressions)
+
+	// Restringir problemas aos arquivos selecionados
+	if opts.Files != nil {
+		result.Problems = s.restrictProblems(result.Problems, path, opts.Files)
+	}
+
+	// Suprimir problemas pré-existentes registrados no baseline
+	if opts.Baseline != nil {
+		matcher := opts.Baseline.NewMatcher()
+		kept := make([]Problem, 0, len(result.Problems))
+		for _, p := range result.Problems {
+			if matcher.Suppress(BaselineEntry(p, path, opts.Baseline.Root())) {
+				result.Suppressed++
+				continue
+			}
+			kept = append(kept, p)
+		}
+		result.Problems = kept
+	}
+
+	// Ordenar e remover duplicatas para uma saída estável (diff, snapshots e baseline)
+	result.Problems = sortProblems(result.Problems)
+
+	// Contar problemas por categoria
+	for _, p := range result.Problems {
+		result.Summary[p.Category]++
+	}
+
+	return result, nil
+}
+
+// sortProblems ordena os problemas por arquivo, linha, categoria e mensagem (problemas sem arquivo,
+// como gaps, primeiro) e remove problemas repetidos
+func sortProblems(problems []Problem) []Problem {
+	sort.SliceStable(problems, func(i, j int) bool {
+		a, b := problems[i], problems[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		if a.Message != b.Message {
+			return a.Message < b.Message
+		}
+		return a.Severity < b.Severity
+	})
+
+	unique := problems[:0]
+	for i, p := range problems {
+		if i > 0 && p == problems[i-1] {
+			continue
+		}
+		unique = append(unique, p)
+	}
+	return unique
+}
+
+// resolvePath determina o diretório de specs (./specs se vazio) e verifica se é um diretório existente
+func (s *Service) resolvePath(path string) (string, error) {
 	if path == "" {
 		wd, err := s.fs.Getwd()
 		if err != nil {
-			return nil, fmt.Errorf("falha ao obter diretório atual: %w", err)
+			return "", fmt.Errorf("falha ao obter diretório atual: %w", err)
 		}
 		path = filepath.Join(wd, "specs")
 	}
 
 	// Verificar se caminho existe
 	if !s.fs.Exists(path) {
-		return nil, fmt.Errorf("caminho não existe: %s", path)
+		return "", fmt.Errorf("caminho não existe: %s", path)
 	}
 
 	// Verificar se é diretório
 	stat, err := s.fs.Stat(path)
 	if err != nil {
-		return nil, fmt.Errorf("falha ao obter informações do caminho: %w", err)
+		return "", fmt.Errorf("falha ao obter informações do caminho: %w", err)
 	}
 
 	if !stat.IsDir() {
-		return nil, fmt.Errorf("caminho não é diretório: %s", path)
+		return "", fmt.Errorf("caminho não é diretório: %s", path)
 	}
 
-	// Listar todos os arquivos .spec.md
-	specFiles, err := s.findSpecFiles(path)
-	if err != nil {
-		return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
+	return path, nil
+}
+
+// categoryRules mapeia categorias de problemas para regras de supressão
+var categoryRules = map[string]string{
+	"Numeração":    suppression.RuleNumbering,
+	"Links":        suppression.RuleLinks,
+	"Formato":      suppression.RuleFormat,
+	"Órfãs":        suppression.RuleOrphans,
+	"Dependências": suppression.RuleDependencies,
+	"Links externos": suppression.RuleExternalLinks,
+	"Títulos":      suppression.RuleTitles,
+}
+
+// loadSuppressions lê diretivas de supressão inline (arquivo -> diretivas)
+func (s *Service) loadSuppressions(files []string) map[string]*suppression.Set {
+	suppressions := make(map[string]*suppression.Set)
+	for _, file := range files {
+		data, err := s.fs.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		set := suppression.Parse(string(data))
+		if len(set.Directives) > 0 {
+			suppressions[file] = set
+		}
 	}
+	return suppressions
+}
 
-	result := &CheckResult{
-		TotalSpecs: len(specFiles),
-		Problems:   []Problem{},
-		Summary:    make(map[string]int),
+// applySuppressions remove problemas suprimidos por diretivas inline e reporta supressões não utilizadas
+func (s *Service) applySuppressions(files []string, basePath string, result *CheckResult, suppressions map[string]*suppression.Set) {
+	sets := make(map[string]*suppression.Set)
+	var withDirectives []string
+	for _, file := range files {
+		set, ok := suppressions[file]
+		if !ok {
+			continue
+		}
+		relPath, _ := filepath.Rel(basePath, file)
+		if relPath == "" || relPath == "." {
+			relPath = filepath.Base(file)
+		}
+		sets[relPath] = set
+		withDirectives = append(withDirectives, relPath)
 	}
 
-	// Construir mapeamento de specs (número -> arquivos)
-	specMap := s.buildSpecMap(specFiles, path)
+	if len(sets) == 0 {
+		return
+	}
 
-	// Validar numeração
-	s.checkNumbering(specFiles, path, result, specMap)
+	kept := make([]Problem, 0, len(result.Problems))
+	for _, p := range result.Problems {
+		if set, ok := sets[p.File]; ok && set.Suppresses(categoryRules[p.Category], p.Line) {
+			continue
+		}
+		kept = append(kept, p)
+	}
 
-	// Validar formato de nomes
-	s.checkFileNameFormat(specFiles, path, result)
+	for _, relPath := range withDirectives {
+		for _, d := range sets[relPath].Unused(suppression.CheckerRules) {
+			kept = append(kept, Problem{
+				Category: "Supressões",
+				Severity: "warning",
+				File:     relPath,
+				Line:     d.Line,
+				Message:  fmt.Sprintf("Supressão não utilizada: %s", d.Rule),
+			})
+		}
+	}
 
-	// Validar links
-	s.checkLinks(specFiles, path, result, specMap)
+	result.Problems = kept
+}
 
-	// Detectar specs órfãs
-	s.checkOrphanedSpecs(specFiles, path, result, specMap)
+// BaselineEntry converte um problema em entrada de baseline com arquivo relativo a root
+func BaselineEntry(p Problem, basePath string, root string) baseline.Entry {
+	file := ""
+	if p.File != "" {
+		file = baseline.RelPath(root, filepath.Join(basePath, p.File))
+	}
+	message := p.Message
+	if p.Key != "" {
+		message = p.Key
+	}
+	return baseline.NewEntry(baseline.SourceCheck, p.Category, file, message)
+}
 
-	// Contar problemas por categoria
-	for _, p := range result.Problems {
-		result.Summary[p.Category]++
+// restrictProblems mantém apenas problemas dos arquivos selecionados. Problemas sem arquivo
+// associado (ex.: gaps) vêm de specs não alteradas e também são descartados.
+func (s *Service) restrictProblems(problems []Problem, basePath string, files []string) []Problem {
+	allowed := make(map[string]bool, len(files))
+	for _, file := range files {
+		absFile, err := filepath.Abs(file)
+		if err != nil {
+			continue
+		}
+		absBase, err := filepath.Abs(basePath)
+		if err != nil {
+			continue
+		}
+		if relPath, err := filepath.Rel(absBase, absFile); err == nil {
+			allowed[relPath] = true
+		}
 	}
 
-	return result, nil
+	restricted := make([]Problem, 0, len(problems))
+	for _, p := range problems {
+		if allowed[p.File] {
+			restricted = append(restricted, p)
+		}
+	}
+	return restricted
 }
 
 // findSpecFiles encontra todos os arquivos .spec.md recursivamente
@@ -119,53 +353,33 @@ func (s *Service) findSpecFiles(root string) ([]string, error) {
 	return files, err
 }
 
-// buildSpecMap constrói mapeamento de número para arquivos
-func (s *Service) buildSpecMap(files []string, basePath string) map[string][]string {
+// buildSpecMap constrói mapeamento de número para arquivos. Cada namespace de numeração tem
+// sequência própria, então a numeração é qualificada pelo diretório (ex.: api/01)
+func (s *Service) buildSpecMap(files []string, basePath string, scheme numbering.Scheme) map[string][]string {
 	specMap := make(map[string][]string)
 	for _, file := range files {
 		fileName := filepath.Base(file)
-		number := s.extractNumber(fileName)
+		number := s.extractNumber(fileName, scheme)
 		if number != "" {
 			relPath, _ := filepath.Rel(basePath, file)
 			if relPath == "" || relPath == "." {
 				relPath = fileName
 			}
-			specMap[number] = append(specMap[number], relPath)
+			key := numbering.Qualify(scheme.Namespace(relPath), number)
+			specMap[key] = append(specMap[key], relPath)
 		}
 	}
 	return specMap
 }
 
 // extractNumber extrai número do nome do arquivo
-func (s *Service) extractNumber(fileName string) string {
-	// Formato esperado: {numero}-{nome}.spec.md
-	parts := strings.SplitN(fileName, "-", 2)
-	if len(parts) < 2 {
-		return ""
-	}
-	number := parts[0]
-	// Validar que é número zero-padded de 2 dígitos
-	if matched, _ := regexp.MatchString(`^\d{2}$`, number); matched {
-		return number
-	}
-	return ""
+func (s *Service) extractNumber(fileName string, scheme numbering.Scheme) string {
+	// Formato esperado: {numero}-{nome}.spec.md, com a numeração no formato do esquema
+	return scheme.Format(scheme.Number(fileName))
 }
 
 // checkNumbering verifica numeração sequencial
-func (s *Service) checkNumbering(files []string, basePath string, result *CheckResult, specMap map[string][]string) {
-	// Extrair números únicos e ordenar
-	numbers := make(map[string]bool)
-	for number := range specMap {
-		numbers[number] = true
-	}
-
-	// Converter para slice e ordenar
-	numberList := make([]string, 0, len(numbers))
-	for number := range numbers {
-		numberList = append(numberList, number)
-	}
-	sort.Strings(numberList)
-
+func (s *Service) checkNumbering(files []string, basePath string, result *CheckResult, specMap map[string][]string, scheme numbering.Scheme) {
 	// Verificar duplicatas
 	for number, files := range specMap {
 		if len(files) > 1 {
@@ -180,35 +394,30 @@ func (s *Service) checkNumbering(files []string, basePath string, result *CheckR
 		}
 	}
 
-	// Verificar gaps
-	if len(numberList) > 0 {
-		// Converter números para inteiros para verificar sequência
-		firstNum := s.numberToInt(numberList[0])
-		lastNum := s.numberToInt(numberList[len(numberList)-1])
-
-		for i := firstNum; i <= lastNum; i++ {
-			numStr := fmt.Sprintf("%02d", i)
-			if !numbers[numStr] {
-				result.Problems = append(result.Problems, Problem{
-					Category: "Numeração",
-					Severity: "warning",
-					Message:  fmt.Sprintf("Gap detectado - falta %s", numStr),
-				})
-			}
+	// Verificar gaps em cada namespace (e em cada nível, no caso de numeração hierárquica)
+	numbers := make(map[string][]numbering.Number)
+	for _, files := range specMap {
+		namespace := scheme.Namespace(files[0])
+		numbers[namespace] = append(numbers[namespace], scheme.Number(filepath.Base(files[0])))
+	}
+	namespaces := make([]string, 0, len(numbers))
+	for namespace := range numbers {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		for _, missing := range numbering.Gaps(numbers[namespace]) {
+			result.Problems = append(result.Problems, Problem{
+				Category: "Numeração",
+				Severity: "warning",
+				Message:  fmt.Sprintf("Gap detectado - falta %s", numbering.Qualify(namespace, scheme.Format(missing))),
+			})
 		}
 	}
 }
 
-// numberToInt converte string de número para int
-func (s *Service) numberToInt(numStr string) int {
-	var num int
-	fmt.Sscanf(numStr, "%d", &num)
-	return num
-}
-
 // checkFileNameFormat verifica formato de nomes de arquivos
-func (s *Service) checkFileNameFormat(files []string, basePath string, result *CheckResult) {
-	fileNameRegex := regexp.MustCompile(`^(\d{2})-(.+?)\.spec\.md$`)
+func (s *Service) checkFileNameFormat(files []string, basePath string, result *CheckResult, scheme numbering.Scheme) {
 	for _, file := range files {
 		fileName := filepath.Base(file)
 		relPath, _ := filepath.Rel(basePath, file)
@@ -216,100 +425,157 @@ func (s *Service) checkFileNameFormat(files []string, basePath string, result *C
 			relPath = fileName
 		}
 
-		if !fileNameRegex.MatchString(fileName) {
+		if _, _, ok := scheme.Split(fileName); !ok {
 			result.Problems = append(result.Problems, Problem{
 				Category: "Formato",
 				Severity: "error",
 				File:     relPath,
-				Message:  fmt.Sprintf("Nome não segue padrão {numero}-{nome}.spec.md"),
+				Message:  fmt.Sprintf("Nome não segue padrão %s-{nome}.spec.md", scheme.Placeholder()),
 			})
-		} else {
-			matches := fileNameRegex.FindStringSubmatch(fileName)
-			if len(matches) >= 3 {
-				name := matches[2]
-				if name == "" {
-					result.Problems = append(result.Problems, Problem{
-						Category: "Formato",
-						Severity: "error",
-						File:     relPath,
-						Message:  "Nome descritivo está vazio (apenas número)",
-					})
-				}
-			}
 		}
 	}
 }
 
-// checkLinks verifica links internos
-func (s *Service) checkLinks(files []string, basePath string, result *CheckResult, specMap map[string][]string) {
-	linkRegex := regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
-	
+// specTitle é o título de uma spec: título principal (# ...) e title do frontmatter
+type specTitle struct {
+	file        string // Relativo ao diretório de specs
+	heading     string // Título principal, com a numeração
+	headingLine int
+	front       string // title do frontmatter (vazio se ausente)
+	frontLine   int
+}
+
+// name retorna o título sem a numeração, preferindo o do frontmatter (como em `specs graph`)
+func (t specTitle) name() string {
+	if t.front != "" {
+		return titlePrefixRegex.ReplaceAllString(t.front, "")
+	}
+	return titlePrefixRegex.ReplaceAllString(t.heading, "")
+}
+
+// checkTitles compara título principal, title do frontmatter e nome do arquivo: numeração do título
+// diferente da do arquivo (erro), frontmatter divergente e títulos repetidos entre specs (avisos) e,
+// se slugSeverity for informada, nome do arquivo que não corresponde ao título (com o nome sugerido)
+func (s *Service) checkTitles(files []string, basePath string, result *CheckResult, scheme numbering.Scheme, slugSeverity string) {
+	var titles []specTitle
 	for _, file := range files {
 		data, err := s.fs.ReadFile(file)
 		if err != nil {
 			continue
 		}
-
 		content := string(data)
-		lines := strings.Split(content, "\n")
-		relPath, _ := filepath.Rel(basePath, file)
-		if relPath == "" || relPath == "." {
-			relPath = filepath.Base(file)
+		meta := metadata.Parse(content)
+		t := specTitle{file: relativeTo(basePath, file), front: strings.TrimSpace(meta.Title), frontLine: meta.Lines["title"]}
+		inFence := false
+		for i, line := range strings.Split(content, "\n") {
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+				inFence = !inFence
+				continue
+			}
+			if i >= meta.EndLine && !inFence && strings.HasPrefix(trimmed, "# ") {
+				t.heading = strings.TrimSpace(trimmed[2:])
+				t.headingLine = i + 1
+				break
+			}
+		}
+		if t.heading == "" && t.front == "" {
+			continue
 		}
+		titles = append(titles, t)
 
-		for lineNum, line := range lines {
-			matches := linkRegex.FindAllStringSubmatch(line, -1)
-			for _, match := range matches {
-				if len(match) >= 3 {
-					linkPath := match[2]
-					// Verificar se é link interno para spec
-					if strings.HasSuffix(linkPath, ".spec.md") {
-						// Extrair nome do arquivo
-						linkFile := filepath.Base(linkPath)
-						// Verificar se arquivo existe no mapeamento
-						linkNumber := s.extractNumber(linkFile)
-						if linkNumber != "" {
-							if _, exists := specMap[linkNumber]; !exists {
-								result.Problems = append(result.Problems, Problem{
-									Category: "Links",
-									Severity: "error",
-									File:     relPath,
-									Line:     lineNum + 1,
-									Message:  fmt.Sprintf("Link para '%s' não encontrado", linkFile),
-								})
-							}
-						} else {
-							// Verificar se arquivo existe no diretório
-							fullPath := filepath.Join(basePath, linkPath)
-							if !s.fs.Exists(fullPath) {
-								result.Problems = append(result.Problems, Problem{
-									Category: "Links",
-									Severity: "error",
-									File:     relPath,
-									Line:     lineNum + 1,
-									Message:  fmt.Sprintf("Link para '%s' não encontrado", linkPath),
-								})
-							}
-						}
-					}
-				}
+		number, name, numbered := scheme.Split(filepath.Base(file))
+
+		// Numeração do título principal ("# 03 - Nome") deve ser a do arquivo
+		if match := titleNumberRegex.FindStringSubmatch("# " + t.heading); numbered && t.heading != "" && match != nil {
+			if titleNumber, _ := scheme.Loose(match[2]); !titleNumber.Equal(number) {
+				result.Problems = append(result.Problems, Problem{
+					Category: "Títulos",
+					Severity: "error",
+					File:     t.file,
+					Line:     t.headingLine,
+					Message:  fmt.Sprintf("Numeração do título (%s) difere da numeração do arquivo (%s)", match[2], strings.TrimPrefix(scheme.Format(number), scheme.Prefix)),
+				})
+			}
+		}
+
+		// title do frontmatter deve corresponder ao título principal
+		if t.front != "" && t.heading != "" &&
+			specSlug(titlePrefixRegex.ReplaceAllString(t.front, "")) != specSlug(titlePrefixRegex.ReplaceAllString(t.heading, "")) {
+			result.Problems = append(result.Problems, Problem{
+				Category: "Títulos",
+				Severity: "warning",
+				File:     t.file,
+				Line:     t.frontLine,
+				Message:  fmt.Sprintf("Título do frontmatter difere do título principal: %q ≠ %q", t.front, t.heading),
+			})
+		}
+
+		// Nome do arquivo deve corresponder ao título (opcional)
+		if slug := specSlug(t.name()); slugSeverity != "" && numbered && slug != "" && slug != name {
+			line := t.headingLine
+			if t.front != "" {
+				line = t.frontLine
 			}
+			result.Problems = append(result.Problems, Problem{
+				Category: "Títulos",
+				Severity: slugSeverity,
+				File:     t.file,
+				Line:     line,
+				Message:  fmt.Sprintf("Nome do arquivo não corresponde ao título: sugerido %s", scheme.FileName(number, slug)),
+			})
+		}
+	}
+
+	// Títulos repetidos entre specs (comparados sem numeração, acentos e maiúsculas)
+	byName := make(map[string][]specTitle)
+	for _, t := range titles {
+		if key := specSlug(t.name()); key != "" {
+			byName[key] = append(byName[key], t)
 		}
 	}
+	for _, t := range titles {
+		same := byName[specSlug(t.name())]
+		if len(same) < 2 {
+			continue
+		}
+		var others []string
+		for _, other := range same {
+			if other.file != t.file {
+				others = append(others, other.file)
+			}
+		}
+		result.Problems = append(result.Problems, Problem{
+			Category: "Títulos",
+			Severity: "warning",
+			File:     t.file,
+			Line:     t.headingLine,
+			Message:  fmt.Sprintf("Título duplicado: %q também usado em %s", t.name(), strings.Join(others, ", ")),
+		})
+	}
 }
 
-// checkOrphanedSpecs detecta specs órfãs (referenciadas mas não existem)
-func (s *Service) checkOrphanedSpecs(files []string, basePath string, result *CheckResult, specMap map[string][]string) {
-	// Construir índice de arquivos existentes
-	existingFiles := make(map[string]bool)
-	for _, file := range files {
-		fileName := filepath.Base(file)
-		existingFiles[fileName] = true
+// checkLinks verifica links para arquivos locais (specs, imagens, checklist.md, código-fonte) e âncoras (#secao).
+// Cada link é resolvido relativo ao diretório do arquivo que o contém e o caminho exato precisa existir.
+func (s *Service) checkLinks(files []string, basePath string, result *CheckResult) {
+	anchors := make(map[string]map[string]bool) // arquivo -> âncoras (cache)
+	anchorsOf := func(path string) (map[string]bool, bool) {
+		if cached, ok := anchors[path]; ok {
+			return cached, true
+		}
+		data, err := s.fs.ReadFile(path)
+		if err != nil {
+			return nil, false
+		}
+		anchors[path] = headingAnchors(string(data))
+		return anchors[path], true
 	}
 
-	// Construir índice de referências
-	referencedSpecs := make(map[string]bool)
-	linkRegex := regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+	// Specs por nome de arquivo, para sugerir o caminho correto de links quebrados
+	specsByName := make(map[string][]string)
+	for _, file := range files {
+		specsByName[filepath.Base(file)] = append(specsByName[filepath.Base(file)], file)
+	}
 
 	for _, file := range files {
 		data, err := s.fs.ReadFile(file)
@@ -317,31 +583,246 @@ func (s *Service) checkOrphanedSpecs(files []string, basePath string, result *Ch
 			continue
 		}
 
-		content := string(data)
-		matches := linkRegex.FindAllStringSubmatch(content, -1)
-		for _, match := range matches {
-			if len(match) >= 3 {
-				linkPath := match[2]
-				if strings.HasSuffix(linkPath, ".spec.md") {
-					linkFile := filepath.Base(linkPath)
-					referencedSpecs[linkFile] = true
+		relPath, _ := filepath.Rel(basePath, file)
+		if relPath == "" || relPath == "." {
+			relPath = filepath.Base(file)
+		}
+		anchors[file] = headingAnchors(string(data))
+
+		for _, link := range localLinks(string(data)) {
+			// Âncora no próprio arquivo
+			if link.Path == "" {
+				if link.Fragment != "" && !hasAnchor(anchors[file], link.Fragment) {
+					result.Problems = append(result.Problems, Problem{
+						Category: "Links",
+						Severity: "error",
+						File:     relPath,
+						Line:     link.Line,
+						Message:  fmt.Sprintf("Âncora '#%s' não encontrada no próprio arquivo", link.Fragment),
+					})
 				}
+				continue
 			}
-		}
-	}
 
-	// Verificar se specs referenciadas existem
-	for refFile := range referencedSpecs {
-		if !existingFiles[refFile] {
-			// Verificar se arquivo existe fisicamente (pode estar em subdiretório)
-			fullPath := filepath.Join(basePath, refFile)
-			if !s.fs.Exists(fullPath) {
+			target := resolveLink(file, link.Path)
+			if !s.fs.Exists(target) {
+				message := fmt.Sprintf("Link para '%s' não encontrado", link.Path)
+				if candidates := specsByName[filepath.Base(link.Path)]; len(candidates) == 1 {
+					suggestion, _ := filepath.Rel(filepath.Dir(file), candidates[0])
+					message += fmt.Sprintf(" (você quis dizer '%s'?)", filepath.ToSlash(suggestion))
+				}
 				result.Problems = append(result.Problems, Problem{
-					Category: "Órfãs",
+					Category: "Links",
 					Severity: "error",
-					Message:  fmt.Sprintf("Spec referenciada mas não existe: %s", refFile),
+					File:     relPath,
+					Line:     link.Line,
+					Message:  message,
+				})
+				continue
+			}
+
+			// Verificar âncora no arquivo de destino (apenas markdown)
+			if link.Fragment == "" || !isMarkdown(target) {
+				continue
+			}
+			targetAnchors, ok := anchorsOf(target)
+			if ok && !hasAnchor(targetAnchors, link.Fragment) {
+				result.Problems = append(result.Problems, Problem{
+					Category: "Links",
+					Severity: "error",
+					File:     relPath,
+					Line:     link.Line,
+					Message:  fmt.Sprintf("Âncora '#%s' não encontrada em '%s'", link.Fragment, link.Path),
 				})
 			}
 		}
 	}
 }
+
+// hasAnchor verifica se a âncora existe (fragmentos são comparados decodificados e sem diferenciar maiúsculas)
+func hasAnchor(anchors map[string]bool, fragment string) bool {
+	if decoded, err := url.PathUnescape(fragment); err == nil {
+		fragment = decoded
+	}
+	return anchors[fragment] || anchors[strings.ToLower(fragment)]
+}
+
+// checkUnreferencedSpecs detecta specs que não são alcançáveis, por links ou depends_on, a partir
+// das specs raiz. Sem specs raiz, detecta specs que nenhuma outra spec referencia.
+func (s *Service) checkUnreferencedSpecs(g *graph.Graph, scheme numbering.Scheme, opts OrphanOptions, result *CheckResult) {
+	roots := make(map[string]bool)
+	for _, n := range g.Nodes {
+		if isRootSpec(n.ID, opts.Roots, scheme) {
+			roots[n.ID] = true
+		}
+	}
+
+	adjacent := make(map[string][]string)
+	referenced := make(map[string]bool)
+	for _, e := range g.Edges {
+		adjacent[e.From] = append(adjacent[e.From], e.To)
+		referenced[e.To] = true
+	}
+
+	// Busca em largura a partir das specs raiz
+	reachable := make(map[string]bool)
+	var queue []string
+	for _, n := range g.Nodes {
+		if roots[n.ID] {
+			reachable[n.ID] = true
+			queue = append(queue, n.ID)
+		}
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, to := range adjacent[id] {
+			if !reachable[to] {
+				reachable[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+
+	for _, n := range g.Nodes {
+		var message string
+		switch {
+		case roots[n.ID] || reachable[n.ID]:
+			continue
+		case !referenced[n.ID]:
+			message = "Spec não referenciada por nenhuma outra spec"
+		case len(roots) > 0:
+			message = "Spec não alcançável a partir das specs raiz"
+		default:
+			continue
+		}
+		result.Problems = append(result.Problems, Problem{
+			Category: "Órfãs",
+			Severity: opts.Severity,
+			File:     n.File,
+			Message:  message,
+		})
+	}
+}
+
+// isRootSpec indica se a spec (ID: caminho relativo sem .spec.md) é raiz: listada pelo nome ou
+// caminho em roots ou, sem roots configuradas, numerada com 00 (contexto global, arquitetura)
+func isRootSpec(id string, roots []string, scheme numbering.Scheme) bool {
+	slug := path.Base(id)
+	if len(roots) == 0 {
+		n := scheme.Number(slug)
+		return len(n) == 1 && n[0] == 0
+	}
+	for _, root := range roots {
+		root = strings.TrimSuffix(filepath.ToSlash(root), ".spec.md")
+		if root == id || root == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDependencies verifica o grafo de dependências (links e depends_on): ciclos de
+// depends_on, dependências inexistentes e specs ativas que dependem de specs obsoletas
+func (s *Service) checkDependencies(g *graph.Graph, result *CheckResult) {
+	fileOf := func(id string) string {
+		if n := g.Node(id); n != nil {
+			return n.File
+		}
+		return id
+	}
+
+	// Apenas ciclos formados por depends_on são reportados: links mútuos ("veja também") são
+	// navegação comum entre specs, e ciclos que passam por links aparecem só em `specs graph`
+	for _, cycle := range g.Cycles {
+		if !cycle.Declared {
+			continue
+		}
+		result.Problems = append(result.Problems, Problem{
+			Category: "Dependências",
+			Severity: "error",
+			File:     fileOf(cycle.Specs[0]),
+			Message:  fmt.Sprintf("Ciclo de dependências: %s", strings.Join(cycle.Specs, " → ")),
+		})
+	}
+
+	for _, u := range g.Unresolved {
+		result.Problems = append(result.Problems, Problem{
+			Category: "Dependências",
+			Severity: "error",
+			File:     fileOf(u.From),
+			Line:     u.Line,
+			Message:  fmt.Sprintf("Dependência '%s' inválida: %s", u.Target, u.Reason),
+		})
+	}
+
+	for _, e := range g.Deprecated {
+		result.Problems = append(result.Problems, Problem{
+			Category: "Dependências",
+			Severity: "warning",
+			File:     fileOf(e.From),
+			Line:     e.Line,
+			Message:  fmt.Sprintf("Depende de spec obsoleta: %s", e.To),
+		})
+	}
+}
+
+// checkExternalLinks verifica links http/https: respostas 4xx são erros; timeouts, erros de rede
+// e 5xx persistentes são avisos, pois podem ser transitórios
+func (s *Service) checkExternalLinks(files []string, basePath string, result *CheckResult, opts linkchecker.Options) error {
+	var occurrences []linkchecker.Occurrence
+	for _, file := range files {
+		data, err := s.fs.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		relPath, _ := filepath.Rel(basePath, file)
+		if relPath == "" || relPath == "." {
+			relPath = filepath.Base(file)
+		}
+		occurrences = append(occurrences, linkchecker.ExternalLinks(relPath, string(data))...)
+	}
+
+	results, err := s.links.Check(occurrences, opts)
+	if err != nil {
+		return err
+	}
+
+	summary := &ExternalSummary{URLs: len(results)}
+	for _, r := range results {
+		switch {
+		case r.Status == linkchecker.StatusSkipped:
+			summary.Skipped++
+		case r.Cached:
+			summary.Cached++
+		default:
+			summary.Checked++
+		}
+
+		// O detalhe do erro (texto de net/http, código HTTP) varia entre execuções; o baseline
+		// usa só o estado e a URL
+		var severity, key string
+		switch r.Status {
+		case linkchecker.StatusBroken:
+			severity, key = "error", fmt.Sprintf("Link externo quebrado: %s", r.URL)
+		case linkchecker.StatusUnreachable:
+			severity, key = "warning", fmt.Sprintf("Link externo inacessível: %s", r.URL)
+		default:
+			continue
+		}
+		message := fmt.Sprintf("%s (%s)", key, r.Error)
+		for _, o := range r.Occurrences {
+			result.Problems = append(result.Problems, Problem{
+				Category: "Links externos",
+				Severity: severity,
+				File:     o.File,
+				Line:     o.Line,
+				Message:  message,
+				Key:      key,
+			})
+		}
+	}
+	result.External = summary
+
+	return nil
+}
@@ -1,11 +1,18 @@
 package checker
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/baseline"
+	"github.com/dreibox/specs/internal/services/linkchecker"
+	"github.com/dreibox/specs/internal/services/numbering"
 )
 
 func TestService_Check_NumberingGap(t *testing.T) {
@@ -240,3 +247,546 @@ func TestService_Check_NotDirectory(t *testing.T) {
 		t.Error("deveria retornar erro para caminho que não é diretório")
 	}
 }
+
+func TestService_Check_RestrictToFiles(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "specs")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	// 01 tem link quebrado e 04 deixa um gap (pré-existentes); 02 foi alterada e aponta para 01 (válido)
+	spec1 := "# 01 Test\n\nVeja [09](09-missing.spec.md)\n"
+	if err := fs.WriteFile(filepath.Join(specsDir, "04-gap.spec.md"), []byte("# 04 Gap\n"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec4: %v", err)
+	}
+	spec2 := "# 02 Other\n\nVeja [01](01-test.spec.md)\n"
+	if err := fs.WriteFile(filepath.Join(specsDir, "01-test.spec.md"), []byte(spec1), 0644); err != nil {
+		t.Fatalf("falha ao criar spec1: %v", err)
+	}
+	if err := fs.WriteFile(filepath.Join(specsDir, "02-other.spec.md"), []byte(spec2), 0644); err != nil {
+		t.Fatalf("falha ao criar spec2: %v", err)
+	}
+
+	result, err := service.Check(CheckOptions{
+		Path:  specsDir,
+		Files: []string{filepath.Join(specsDir, "02-other.spec.md")},
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	// Nem problemas de arquivos não selecionados nem problemas sem arquivo (gap)
+	for _, p := range result.Problems {
+		t.Errorf("não deveria reportar problemas fora dos arquivos selecionados: %s: %s", p.File, p.Message)
+	}
+	if result.TotalSpecs != 3 {
+		t.Errorf("esperado 3 specs na árvore, obtido %d", result.TotalSpecs)
+	}
+}
+
+func TestService_Check_Baseline(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+	baselineService := baseline.NewService(fs)
+
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "specs")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	spec1 := "# 01 Test\n\nVeja [09](09-missing.spec.md)\n"
+	if err := fs.WriteFile(filepath.Join(specsDir, "01-test.spec.md"), []byte(spec1), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	before, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	var entries []baseline.Entry
+	for _, p := range before.Problems {
+		entries = append(entries, BaselineEntry(p, specsDir, specsDir))
+	}
+	b, err := baselineService.Save(specsDir, entries)
+	if err != nil {
+		t.Fatalf("falha ao salvar baseline: %v", err)
+	}
+
+	// Problema pré-existente muda de linha e um novo problema aparece
+	spec1 = "# 01 Test\n\nTexto novo\n\nVeja [09](09-missing.spec.md)\nVeja [08](08-new.spec.md)\n"
+	if err := fs.WriteFile(filepath.Join(specsDir, "01-test.spec.md"), []byte(spec1), 0644); err != nil {
+		t.Fatalf("falha ao alterar spec: %v", err)
+	}
+
+	result, err := service.Check(CheckOptions{Path: specsDir, Baseline: b})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if result.Suppressed != len(before.Problems) {
+		t.Errorf("esperado %d suprimido(s), obtido %d", len(before.Problems), result.Suppressed)
+	}
+	for _, p := range result.Problems {
+		if strings.Contains(p.Message, "09-missing") {
+			t.Errorf("problema do baseline não deveria ser reportado: %s", p.Message)
+		}
+	}
+	if len(result.Problems) == 0 {
+		t.Error("problema novo deveria ser reportado")
+	}
+}
+
+func TestService_Check_InlineSuppression(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "specs")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	spec := `# 01 Test
+
+<!-- specs-disable-next-line links -->
+Veja [futura](02-futura.spec.md)
+Veja [outra](03-outra.spec.md)
+
+<!-- specs-disable-next-line links -->
+Linha sem links
+`
+	if err := fs.WriteFile(filepath.Join(specsDir, "01-test.spec.md"), []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	result, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	var linkLines []int
+	unused := 0
+	for _, p := range result.Problems {
+		switch p.Category {
+		case "Links":
+			linkLines = append(linkLines, p.Line)
+		case "Supressões":
+			unused++
+			if p.Line != 7 {
+				t.Errorf("esperado supressão não utilizada na linha 7, obtido %d", p.Line)
+			}
+		}
+	}
+	if len(linkLines) != 1 || linkLines[0] != 5 {
+		t.Errorf("esperado apenas link da linha 5, obtido %v", linkLines)
+	}
+	if unused != 1 {
+		t.Errorf("esperado 1 supressão não utilizada, obtido %d", unused)
+	}
+}
+
+func TestService_Check_Dependencies(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "specs")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	specs := map[string]string{
+		"01-a.spec.md": "---\ndepends_on: [02]\n---\n# 01 A\n",
+		"02-b.spec.md": "---\nstatus: deprecated\ndepends_on: [01, 09]\n---\n# 02 B\n",
+		"03-c.spec.md": "---\ndepends_on: [02]\n---\n# 03 C\n<!-- specs-disable: dependencies -->\n",
+		// Ciclos que passam por links ("veja também") não são reportados
+		"04-d.spec.md": "# 04 D\n[E](05-e.spec.md) [F](06-f.spec.md)\n",
+		"05-e.spec.md": "---\ndepends_on: [04]\n---\n# 05 E\n",
+		"06-f.spec.md": "# 06 F\n[D](04-d.spec.md)\n",
+		// Ciclo de depends_on (07 → 10 → 07) em specs também ligadas por links (07 → 08 → 10)
+		"07-g.spec.md": "---\ndepends_on: [10-j]\n---\n# 07 G\n[H](08-h.spec.md)\n",
+		"08-h.spec.md": "# 08 H\n[J](10-j.spec.md)\n",
+		"10-j.spec.md": "---\ndepends_on: [07-g]\n---\n# 10 J\n",
+	}
+	for name, content := range specs {
+		if err := fs.WriteFile(filepath.Join(specsDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+
+	result, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	var messages []string
+	for _, p := range result.Problems {
+		if p.Category == "Dependências" {
+			messages = append(messages, p.Severity+" "+p.File+": "+p.Message)
+		}
+	}
+	// Problemas ordenados por arquivo, linha e categoria
+	expected := []string{
+		"error 01-a.spec.md: Ciclo de dependências: 01-a → 02-b → 01-a",
+		"warning 01-a.spec.md: Depende de spec obsoleta: 02-b",
+		"error 02-b.spec.md: Dependência '09' inválida: spec inexistente: 09",
+		"error 07-g.spec.md: Ciclo de dependências: 07-g → 10-j → 07-g",
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(messages, "\n"))
+	}
+}
+
+func TestService_Check_ExternalLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	specs := map[string]string{
+		"01-a.spec.md": "# 01 A\n[ok](" + server.URL + "/ok)\n[quebrado](" + server.URL + "/missing)\n",
+		"02-b.spec.md": "# 02 B\n<!-- specs-disable-next-line external-links -->\n[quebrado](" + server.URL + "/missing)\n",
+	}
+	for name, content := range specs {
+		if err := fs.WriteFile(filepath.Join(specsDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+
+	// Sem a opção, links externos não são verificados
+	result, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if result.External != nil || result.Summary["Links externos"] != 0 {
+		t.Fatalf("links externos verificados sem --external: %+v", result.Problems)
+	}
+
+	result, err = service.Check(CheckOptions{
+		Path:     specsDir,
+		External: &linkchecker.Options{HostRate: -1, Retries: -1},
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	var messages []string
+	for _, p := range result.Problems {
+		if p.Category == "Links externos" {
+			messages = append(messages, fmt.Sprintf("%s %s:%d", p.Severity, p.File, p.Line))
+
+			// O fingerprint do baseline não depende do detalhe do erro, que varia entre execuções
+			want := "Link externo quebrado: " + server.URL + "/missing"
+			if entry := BaselineEntry(p, specsDir, specsDir); entry.Message != want {
+				t.Errorf("entrada de baseline esperada %q, obtida %q", want, entry.Message)
+			}
+		}
+	}
+	if strings.Join(messages, " ") != "error 01-a.spec.md:3" {
+		t.Errorf("problemas inesperados: %v", messages)
+	}
+	if result.External == nil || result.External.URLs != 2 || result.External.Checked != 2 {
+		t.Errorf("resumo inesperado: %+v", result.External)
+	}
+}
+
+func TestService_Check_NumberingScheme(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+
+	problems := func(scheme numbering.Scheme, files map[string]string) []string {
+		t.Helper()
+		result, err := service.Check(CheckOptions{Path: writeFixSpecs(t, files), Numbering: scheme})
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		var messages []string
+		for _, p := range result.Problems {
+			messages = append(messages, p.File+": "+p.Message)
+		}
+		sort.Strings(messages)
+		return messages
+	}
+
+	// Padrão: mais de 99 specs continuam válidas; zeros à esquerda a mais não
+	got := problems(numbering.Scheme{}, map[string]string{
+		"98-a.spec.md":  "# 98 - A\n",
+		"99-b.spec.md":  "# 99 - B\n",
+		"100-c.spec.md": "# 100 - C\n",
+		"101-d.spec.md": "# 101 - D\n",
+		"007-e.spec.md": "# 007 - E\n",
+	})
+	if strings.Join(got, "\n") != "007-e.spec.md: Nome não segue padrão {numero}-{nome}.spec.md" {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+
+	// Prefixo e largura configuráveis
+	adr, _ := numbering.ParseScheme("ADR-0000")
+	got = problems(adr, map[string]string{
+		"ADR-0001-a.spec.md": "# ADR-0001 - A\n",
+		"ADR-0003-b.spec.md": "# ADR-0003 - B\n",
+		"ADR-0003-c.spec.md": "# ADR-0003 - C\n",
+		"01-d.spec.md":       "# 01 - D\n",
+	})
+	expected := []string{
+		"01-d.spec.md: Nome não segue padrão ADR-{numero}-{nome}.spec.md",
+		": Gap detectado - falta ADR-0002",
+		"ADR-0003-b.spec.md: Numeração duplicada: ADR-0003 usado em 2 arquivo(s)",
+		"ADR-0003-c.spec.md: Numeração duplicada: ADR-0003 usado em 2 arquivo(s)",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+
+	// Numeração hierárquica: gaps verificados em cada nível
+	hierarchical, _ := numbering.ParseScheme("00.0")
+	got = problems(hierarchical, map[string]string{
+		"01-a.spec.md":     "# 01 - A\n",
+		"01.1-b.spec.md":   "# 01.1 - B\n",
+		"01.3-c.spec.md":   "# 01.3 - C\n",
+		"02-d.spec.md":     "# 02 - D\n",
+		"02.1.1-e.spec.md": "# 02.1.1 - E\n",
+	})
+	if strings.Join(got, "\n") != ": Gap detectado - falta 01.2" {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestService_Check_NumberingNamespaces(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+	specsDir := writeFixSpecs(t, map[string]string{
+		"00-visao.spec.md":     "# 00 - Visão\n",
+		"01-geral.spec.md":     "---\ndepends_on: [api/02]\n---\n# 01 - Geral\n",
+		"api/01-auth.spec.md":  "# 01 - Auth\n",
+		"api/02-users.spec.md": "---\ndepends_on: [01]\n---\n# 02 - Users\n",
+		"api/04-admin.spec.md": "# 04 - Admin\n",
+		"cli/01-init.spec.md":  "# 01 - Init\n",
+		"cli/01-outra.spec.md": "# 01 - Outra\n",
+	})
+
+	scheme := numbering.Scheme{Namespaces: []string{"api", "cli"}}
+	result, err := service.Check(CheckOptions{Path: specsDir, Numbering: scheme})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	var got []string
+	for _, p := range result.Problems {
+		got = append(got, filepath.ToSlash(p.File)+": "+p.Message)
+	}
+	sort.Strings(got)
+
+	// Cada namespace tem sequência própria; depends_on por numeração resolve no namespace da spec
+	expected := []string{
+		": Gap detectado - falta api/03",
+		"cli/01-init.spec.md: Numeração duplicada: cli/01 usado em 2 arquivo(s)",
+		"cli/01-outra.spec.md: Numeração duplicada: cli/01 usado em 2 arquivo(s)",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+
+	// Sem namespaces, a numeração é única em todo o diretório (01 em 4 arquivos, falta 03)
+	result, err = service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if result.Summary["Numeração"] != 5 || result.Summary["Dependências"] != 1 {
+		t.Errorf("esperadas duplicatas globais e dependências ambíguas: %+v", result.Problems)
+	}
+}
+
+func TestService_Check_MissingReferencedSpecs(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-a.spec.md": "# 01 - A\n[B](02-b.spec.md) [X](08-x.spec.md)\n`[Y](09-y.spec.md)`\n```\n[Z](07-z.spec.md)\n```\n",
+		"02-b.spec.md": "# 02 - B\n",
+	})
+
+	result, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	var messages []string
+	for _, p := range result.Problems {
+		messages = append(messages, fmt.Sprintf("%s %s:%d: %s", p.Category, p.File, p.Line, p.Message))
+	}
+	// Reportada uma única vez, como link quebrado. Links em blocos de código e código inline
+	// são exemplos, não referências
+	if strings.Join(messages, "\n") != "Links 01-a.spec.md:2: Link para '08-x.spec.md' não encontrado" {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(messages, "\n"))
+	}
+}
+
+func TestService_Check_UnreferencedSpecs(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+	specsDir := writeFixSpecs(t, map[string]string{
+		"00-global-context.spec.md": "# 00 - Contexto\n[Init](01-init.spec.md)\n",
+		"01-init.spec.md":           "# 01 - Init\n",
+		"02-list.spec.md":           "---\ndepends_on: [01]\n---\n# 02 - List\n",
+		"03-ilha-a.spec.md":         "# 03 - Ilha A\n[B](04-ilha-b.spec.md)\n",
+		"04-ilha-b.spec.md":         "# 04 - Ilha B\n[A](03-ilha-a.spec.md)\n",
+		"05-suprimida.spec.md":      "<!-- specs-disable: orphans -->\n# 05 - Suprimida\n",
+	})
+
+	problems := func(opts *OrphanOptions) []string {
+		t.Helper()
+		result, err := service.Check(CheckOptions{Path: specsDir, Orphans: opts})
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		var messages []string
+		for _, p := range result.Problems {
+			if p.Category == "Órfãs" {
+				messages = append(messages, p.Severity+" "+p.File+": "+p.Message)
+			}
+		}
+		sort.Strings(messages)
+		return messages
+	}
+
+	// Desativada por padrão
+	if got := problems(nil); len(got) != 0 {
+		t.Errorf("esperado nenhum problema sem a detecção: %v", got)
+	}
+
+	// Raiz padrão (00-*): 02 referencia 01, mas ninguém referencia 02; 03 e 04 só se referenciam
+	expected := []string{
+		"warning 02-list.spec.md: Spec não referenciada por nenhuma outra spec",
+		"warning 03-ilha-a.spec.md: Spec não alcançável a partir das specs raiz",
+		"warning 04-ilha-b.spec.md: Spec não alcançável a partir das specs raiz",
+	}
+	if got := problems(&OrphanOptions{Severity: "warning"}); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+
+	// Raízes configuradas: 01 é alcançável por depends_on de 02; 04, por link de 03
+	expected = []string{
+		"error 00-global-context.spec.md: Spec não referenciada por nenhuma outra spec",
+	}
+	if got := problems(&OrphanOptions{Severity: "error", Roots: []string{"02-list", "03-ilha-a.spec.md"}}); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestService_Check_Titles(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-init.spec.md":          "# 01 - Init\n",
+		"02-listagem.spec.md":      "# 03 - Listagem\n",
+		"03-config.spec.md":        "---\ntitle: Configuração Global\n---\n# 03 - Config\n",
+		"04-init-dup.spec.md":      "```\n# 99 - Exemplo\n```\n# 04 - Init\n",
+		"05-validacao.spec.md":     "# 05 - Validação\n",
+		"06-suprimida.spec.md":     "<!-- specs-disable: titles -->\n# 07 - Suprimida\n",
+		"template-default.spec.md": "# Template\n",
+	})
+
+	problems := func(slugSeverity string) []string {
+		t.Helper()
+		result, err := service.Check(CheckOptions{Path: specsDir, TitleSlug: slugSeverity})
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		var messages []string
+		for _, p := range result.Problems {
+			if p.Category == "Títulos" {
+				messages = append(messages, fmt.Sprintf("%s %s:%d: %s", p.Severity, p.File, p.Line, p.Message))
+			}
+		}
+		sort.Strings(messages)
+		return messages
+	}
+
+	// Numeração do título, frontmatter divergente e títulos repetidos (blocos de código são ignorados)
+	expected := []string{
+		`error 02-listagem.spec.md:1: Numeração do título (03) difere da numeração do arquivo (02)`,
+		`warning 01-init.spec.md:1: Título duplicado: "Init" também usado em 04-init-dup.spec.md`,
+		`warning 03-config.spec.md:2: Título do frontmatter difere do título principal: "Configuração Global" ≠ "03 - Config"`,
+		`warning 04-init-dup.spec.md:4: Título duplicado: "Init" também usado em 01-init.spec.md`,
+	}
+	if got := problems(""); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+
+	// Nome do arquivo comparado ao título (frontmatter tem precedência), com o nome sugerido
+	expected = append(expected,
+		`warning 03-config.spec.md:2: Nome do arquivo não corresponde ao título: sugerido 03-configuracao-global.spec.md`,
+		`warning 04-init-dup.spec.md:4: Nome do arquivo não corresponde ao título: sugerido 04-init.spec.md`,
+	)
+	sort.Strings(expected)
+	if got := problems("warning"); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("problemas inesperados:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestService_Check_DeterministicOutput(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+	specsDir := writeFixSpecs(t, map[string]string{
+		"01-a.spec.md":     "# 01 - A\n[X](99-x.spec.md)\n[X](99-x.spec.md)\n",
+		"01-b.spec.md":     "# 01 - B\n",
+		"01-c.spec.md":     "# 01 - C\n",
+		"03-d.spec.md":     "# 03 - D\n[Y](98-y.spec.md) [Y](98-y.spec.md)\n",
+		"sub/03-e.spec.md": "# 03 - E\n",
+	})
+
+	format := func(problems []Problem) string {
+		var lines []string
+		for _, p := range problems {
+			lines = append(lines, fmt.Sprintf("%s:%d [%s] %s", filepath.ToSlash(p.File), p.Line, p.Category, p.Message))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	first, err := service.Check(CheckOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	// Mesma saída em execuções repetidas (duplicatas de numeração vêm de um map)
+	for i := 0; i < 20; i++ {
+		result, err := service.Check(CheckOptions{Path: specsDir})
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if format(result.Problems) != format(first.Problems) {
+			t.Fatalf("saída mudou entre execuções:\n%s\n---\n%s", format(first.Problems), format(result.Problems))
+		}
+	}
+
+	// Ordenada por arquivo, linha e categoria, sem problemas repetidos
+	sorted := sort.SliceIsSorted(first.Problems, func(i, j int) bool {
+		a, b := first.Problems[i], first.Problems[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Category < b.Category
+	})
+	if !sorted {
+		t.Errorf("problemas fora de ordem:\n%s", format(first.Problems))
+	}
+	seen := make(map[Problem]bool)
+	for _, p := range first.Problems {
+		if seen[p] {
+			t.Errorf("problema repetido: %+v", p)
+		}
+		seen[p] = true
+	}
+	if first.Problems[0].File != "" {
+		t.Errorf("problemas sem arquivo (gaps) deveriam vir primeiro:\n%s", format(first.Problems))
+	}
+}
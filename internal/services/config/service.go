@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/numbering"
 )
 
 // Service gerencia configuração do CLI
@@ -38,8 +40,14 @@ type Config struct {
 
 // SpecsConfig contém configurações relacionadas a specs
 type SpecsConfig struct {
-	DefaultPath      string `json:"default_path"`
-	ExcludeTemplates bool   `json:"exclude_templates"`
+	DefaultPath         string   `json:"default_path"`
+	ExcludeTemplates    bool     `json:"exclude_templates"`
+	CoveragePattern     string   `json:"coverage_pattern,omitempty"`     // Vazio = padrão do comando coverage
+	Numbering           string   `json:"numbering,omitempty"`            // Modelo de numeração (vazio = "00")
+	NumberingNamespaces []string `json:"numbering_namespaces,omitempty"` // Diretórios com numeração própria
+	Orphans             string   `json:"orphans,omitempty"`              // Specs não referenciadas: off (vazio), warning ou error
+	OrphanRoots         []string `json:"orphan_roots,omitempty"`         // Specs raiz da detecção de órfãs (vazio = specs 00-*)
+	TitleSlug           string   `json:"title_slug,omitempty"`           // Nome do arquivo diferente do título: off (vazio), warning ou error
 }
 
 // DefaultConfig retorna configuração padrão
@@ -149,6 +157,35 @@ func (s *Service) Validate(config *Config) error {
 		return fmt.Errorf("specs.default_path não pode ser vazio")
 	}
 
+	// Validar coverage_pattern (opcional)
+	if config.Specs.CoveragePattern != "" {
+		if _, err := regexp.Compile(config.Specs.CoveragePattern); err != nil {
+			return fmt.Errorf("specs.coverage_pattern inválido: %w", err)
+		}
+	}
+
+	// Validar numbering (opcional)
+	if _, err := numbering.ParseScheme(config.Specs.Numbering); err != nil {
+		return fmt.Errorf("specs.numbering inválido: %w", err)
+	}
+
+	// Validar numbering_namespaces (opcional): diretórios relativos ao diretório de specs
+	for _, dir := range config.Specs.NumberingNamespaces {
+		namespace := numbering.CleanNamespace(dir)
+		if namespace == "" || filepath.IsAbs(dir) || namespace == ".." || strings.HasPrefix(namespace, "../") {
+			return fmt.Errorf("specs.numbering_namespaces inválido: %q não é um diretório relativo ao diretório de specs", dir)
+		}
+	}
+
+	// Validar orphans e title_slug (opcionais)
+	for _, option := range [][2]string{{"orphans", config.Specs.Orphans}, {"title_slug", config.Specs.TitleSlug}} {
+		switch option[1] {
+		case "", "off", "warning", "error":
+		default:
+			return fmt.Errorf("specs.%s inválido: %s (use off, warning ou error)", option[0], option[1])
+		}
+	}
+
 	// Valores booleanos já são validados pelo JSON unmarshal
 	return nil
 }
@@ -178,6 +215,18 @@ func (s *Service) GetValue(key string) (interface{}, error) {
 		return config.Specs.DefaultPath, nil
 	case "exclude_templates":
 		return config.Specs.ExcludeTemplates, nil
+	case "coverage_pattern":
+		return config.Specs.CoveragePattern, nil
+	case "numbering":
+		return config.Specs.Numbering, nil
+	case "numbering_namespaces":
+		return strings.Join(config.Specs.NumberingNamespaces, ","), nil
+	case "orphans":
+		return config.Specs.Orphans, nil
+	case "orphan_roots":
+		return strings.Join(config.Specs.OrphanRoots, ","), nil
+	case "title_slug":
+		return config.Specs.TitleSlug, nil
 	default:
 		return nil, fmt.Errorf("chave desconhecida: %s", key)
 	}
@@ -204,6 +253,21 @@ func (s *Service) ResolveDefaultPath() (string, error) {
 	return defaultPath, nil
 }
 
+// NumberingScheme retorna o esquema de numeração de specs configurado (specs.numbering e
+// specs.numbering_namespaces)
+func (s *Service) NumberingScheme() (numbering.Scheme, error) {
+	config, err := s.Load()
+	if err != nil {
+		return numbering.Scheme{}, err
+	}
+	scheme, err := numbering.ParseScheme(config.Specs.Numbering)
+	if err != nil {
+		return numbering.Scheme{}, fmt.Errorf("specs.numbering inválido: %w", err)
+	}
+	scheme.Namespaces = config.Specs.NumberingNamespaces
+	return scheme, nil
+}
+
 // SetValue define valor de uma chave específica
 func (s *Service) SetValue(key string, value interface{}) error {
 	// Carregar configuração existente ou usar padrão
@@ -254,6 +318,44 @@ func (s *Service) SetValue(key string, value interface{}) error {
 			}
 		}
 		config.Specs.ExcludeTemplates = boolValue
+	case "coverage_pattern":
+		strValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("valor inválido para %s: deve ser string", key)
+		}
+		config.Specs.CoveragePattern = strValue
+	case "numbering":
+		strValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("valor inválido para %s: deve ser string", key)
+		}
+		config.Specs.Numbering = strValue
+	case "numbering_namespaces":
+		strValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("valor inválido para %s: deve ser string", key)
+		}
+		// Lista separada por vírgulas (ex.: api,cli); "-" ou vazio remove os namespaces
+		config.Specs.NumberingNamespaces = splitList(strValue)
+	case "orphans":
+		strValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("valor inválido para %s: deve ser string", key)
+		}
+		config.Specs.Orphans = strings.ToLower(strValue)
+	case "orphan_roots":
+		strValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("valor inválido para %s: deve ser string", key)
+		}
+		// Lista separada por vírgulas (ex.: 00-global-context,api/00-index); "-" remove
+		config.Specs.OrphanRoots = splitList(strValue)
+	case "title_slug":
+		strValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("valor inválido para %s: deve ser string", key)
+		}
+		config.Specs.TitleSlug = strings.ToLower(strValue)
 	default:
 		return fmt.Errorf("chave desconhecida: %s", key)
 	}
@@ -261,3 +363,17 @@ func (s *Service) SetValue(key string, value interface{}) error {
 	// Salvar configuração
 	return s.Save(config)
 }
+
+// splitList interpreta uma lista separada por vírgulas, ignorando itens vazios ("-" = lista vazia)
+func splitList(value string) []string {
+	if value == "-" {
+		return nil
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
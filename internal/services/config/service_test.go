@@ -200,6 +200,16 @@ func TestService_Validate(t *testing.T) {
 			config:  nil,
 			wantErr: true,
 		},
+		{
+			name: "config com coverage_pattern inválido",
+			config: &Config{
+				Specs: SpecsConfig{
+					DefaultPath:     "./specs",
+					CoveragePattern: "spec:(",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -307,6 +317,66 @@ func TestService_SetValue(t *testing.T) {
 			value:   "",
 			wantErr: true,
 		},
+		{
+			name:    "definir coverage_pattern válido",
+			key:     "specs.coverage_pattern",
+			value:   `req:\s*(?P<spec>\S+)\s+(?P<reqs>RF\d+)`,
+			wantErr: false,
+		},
+		{
+			name:    "coverage_pattern inválido",
+			key:     "specs.coverage_pattern",
+			value:   "spec:(",
+			wantErr: true,
+		},
+		{
+			name:    "definir numbering com prefixo",
+			key:     "specs.numbering",
+			value:   "ADR-0000",
+			wantErr: false,
+		},
+		{
+			name:    "numbering inválido",
+			key:     "specs.numbering",
+			value:   "NN",
+			wantErr: true,
+		},
+		{
+			name:    "definir numbering_namespaces",
+			key:     "specs.numbering_namespaces",
+			value:   "api,cli",
+			wantErr: false,
+		},
+		{
+			name:    "definir orphans",
+			key:     "specs.orphans",
+			value:   "warning",
+			wantErr: false,
+		},
+		{
+			name:    "orphans inválido",
+			key:     "specs.orphans",
+			value:   "sempre",
+			wantErr: true,
+		},
+		{
+			name:    "definir title_slug",
+			key:     "specs.title_slug",
+			value:   "error",
+			wantErr: false,
+		},
+		{
+			name:    "definir orphan_roots",
+			key:     "specs.orphan_roots",
+			value:   "00-global-context,api/00-index",
+			wantErr: false,
+		},
+		{
+			name:    "numbering_namespaces fora do diretório de specs",
+			key:     "specs.numbering_namespaces",
+			value:   "api,../fora",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -341,3 +411,37 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("ExcludeTemplates esperado true, obtido %v", config.Specs.ExcludeTemplates)
 	}
 }
+
+func TestService_NumberingScheme(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	service := NewServiceWithPath(adapters.NewFileSystem(), configPath)
+
+	// Sem configuração: padrão de dois dígitos
+	scheme, err := service.NumberingScheme()
+	if err != nil || scheme.String() != "00" {
+		t.Errorf("esquema padrão inesperado: %v (erro: %v)", scheme, err)
+	}
+
+	if err := service.SetValue("specs.numbering", "00.0"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	scheme, err = service.NumberingScheme()
+	if err != nil || !scheme.Hierarchical || scheme.Width != 2 {
+		t.Errorf("esquema inesperado: %+v (erro: %v)", scheme, err)
+	}
+
+	// Namespaces: lista separada por vírgulas; "-" remove
+	if err := service.SetValue("specs.numbering_namespaces", " api , ./cli/"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	scheme, err = service.NumberingScheme()
+	if err != nil || scheme.Namespace("cli/01-init.spec.md") != "cli" || scheme.Namespace("docs/01-x.spec.md") != "" {
+		t.Errorf("namespaces inesperados: %+v (erro: %v)", scheme, err)
+	}
+	if err := service.SetValue("specs.numbering_namespaces", "-"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if scheme, _ = service.NumberingScheme(); len(scheme.Namespaces) != 0 {
+		t.Errorf("namespaces não foram removidos: %+v", scheme)
+	}
+}
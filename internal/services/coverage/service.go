@@ -0,0 +1,386 @@
+package coverage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
+	"github.com/dreibox/specs/internal/services/validator"
+)
+
+// DefaultPattern reconhece anotações no formato `spec: <spec> RFNN[, RFNN...]`, onde <spec> é o
+// nome completo (03-specs-validate), o nome sem numeração (specs-validate) ou a numeração (03,
+// ou api/03 com namespaces de numeração).
+// Padrões customizados devem ter os grupos nomeados "spec" e "reqs".
+const DefaultPattern = `spec:\s*(?P<spec>[\w./-]+)\s+(?P<reqs>RF\d+(?:\s*,\s*RF\d+)*)`
+
+// DefaultExtensions são as extensões de arquivos de código varridas por padrão
+var DefaultExtensions = []string{".go"}
+
+// skippedDirs são diretórios nunca varridos
+var skippedDirs = map[string]bool{
+	".git":         true,
+	"vendor":       true,
+	"node_modules": true,
+}
+
+var requirementIDRegex = regexp.MustCompile(`RF\d+`)
+
+// Service mapeia anotações no código-fonte para requisitos das specs
+type Service struct {
+	fs        adapters.FileSystem
+	validator *validator.Service
+}
+
+// NewService cria uma nova instância do Service
+func NewService(fs adapters.FileSystem) *Service {
+	return &Service{
+		fs:        fs,
+		validator: validator.NewService(fs),
+	}
+}
+
+// CoverageOptions contém opções para cálculo de cobertura
+type CoverageOptions struct {
+	SpecsPath  string   // Diretório de specs
+	SourcePath string   // Diretório de código-fonte a varrer
+	Pattern    string   // Expressão regular das anotações (vazio = DefaultPattern)
+	Extensions []string // Extensões varridas (vazio = DefaultExtensions)
+
+	Numbering numbering.Scheme // Esquema de numeração, para resolver anotações pela numeração (valor zero = padrão)
+}
+
+// Annotation é uma referência a requisito encontrada no código-fonte
+type Annotation struct {
+	File        string // Relativo a SourcePath
+	Line        int
+	Spec        string // Spec como escrita na anotação
+	Requirement string
+	Reason      string // Preenchido apenas em anotações inválidas
+}
+
+// RequirementCoverage contém as anotações que implementam um requisito
+type RequirementCoverage struct {
+	ID          string
+	Title       string
+	Annotations []Annotation
+}
+
+// Implemented indica se o requisito possui ao menos uma anotação
+func (r RequirementCoverage) Implemented() bool {
+	return len(r.Annotations) > 0
+}
+
+// SpecCoverage contém a cobertura de implementação de uma spec
+type SpecCoverage struct {
+	File         string // Relativo a SpecsPath
+	Requirements []RequirementCoverage
+	Implemented  int
+	Percent      int
+}
+
+// CoverageResult contém o resultado agregado
+type CoverageResult struct {
+	Specs             []SpecCoverage
+	Invalid           []Annotation // Anotações para specs ou requisitos inexistentes
+	Annotations       int
+	TotalRequirements int
+	Implemented       int
+	Percent           int
+}
+
+// CompilePattern compila o padrão de anotações, exigindo os grupos nomeados "spec" e "reqs"
+func CompilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		pattern = DefaultPattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("padrão de anotação inválido: %w", err)
+	}
+	if re.SubexpIndex("spec") < 0 || re.SubexpIndex("reqs") < 0 {
+		return nil, fmt.Errorf("padrão de anotação deve conter os grupos nomeados (?P<spec>...) e (?P<reqs>...)")
+	}
+	return re, nil
+}
+
+// Coverage varre o código-fonte em busca de anotações e calcula a cobertura de cada spec
+func (s *Service) Coverage(opts CoverageOptions) (*CoverageResult, error) {
+	re, err := CompilePattern(opts.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := opts.Extensions
+	if len(extensions) == 0 {
+		extensions = DefaultExtensions
+	}
+
+	for _, dir := range []string{opts.SpecsPath, opts.SourcePath} {
+		stat, err := s.fs.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("caminho não existe: %s", dir)
+		}
+		if !stat.IsDir() {
+			return nil, fmt.Errorf("caminho não é diretório: %s", dir)
+		}
+	}
+
+	specs, index, err := s.loadSpecs(opts.SpecsPath, opts.Numbering)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err := s.scan(opts.SourcePath, opts.SpecsPath, extensions, re)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CoverageResult{Annotations: len(annotations)}
+	for _, a := range annotations {
+		specIdx, reason := index.resolve(a.Spec)
+		if specIdx < 0 {
+			a.Reason = reason
+			result.Invalid = append(result.Invalid, a)
+			continue
+		}
+		reqIdx := -1
+		for i, req := range specs[specIdx].Requirements {
+			if req.ID == a.Requirement {
+				reqIdx = i
+				break
+			}
+		}
+		if reqIdx < 0 {
+			a.Reason = fmt.Sprintf("requisito inexistente em %s", specs[specIdx].File)
+			result.Invalid = append(result.Invalid, a)
+			continue
+		}
+		req := &specs[specIdx].Requirements[reqIdx]
+		req.Annotations = append(req.Annotations, a)
+	}
+
+	for i := range specs {
+		spec := &specs[i]
+		if len(spec.Requirements) == 0 {
+			continue
+		}
+		for _, req := range spec.Requirements {
+			if req.Implemented() {
+				spec.Implemented++
+			}
+		}
+		spec.Percent = percent(spec.Implemented, len(spec.Requirements))
+		result.TotalRequirements += len(spec.Requirements)
+		result.Implemented += spec.Implemented
+		result.Specs = append(result.Specs, *spec)
+	}
+	result.Percent = percent(result.Implemented, result.TotalRequirements)
+
+	return result, nil
+}
+
+// specFile é um arquivo de spec encontrado no diretório de specs
+type specFile struct {
+	path string // Caminho completo
+	rel  string // Relativo ao diretório de specs
+	slug string // Nome sem a extensão .spec.md
+}
+
+// specFiles lista as specs do diretório em ordem de caminho
+func (s *Service) specFiles(root string) ([]specFile, error) {
+	var paths []string
+	err := s.fs.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".spec.md") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
+	}
+	sort.Strings(paths)
+
+	files := make([]specFile, 0, len(paths))
+	for _, path := range paths {
+		rel, _ := filepath.Rel(root, path)
+		files = append(files, specFile{
+			path: path,
+			rel:  rel,
+			slug: strings.TrimSuffix(filepath.Base(path), ".spec.md"),
+		})
+	}
+	return files, nil
+}
+
+// loadSpecs lê os requisitos de cada spec e monta o índice usado para resolver anotações
+func (s *Service) loadSpecs(root string, scheme numbering.Scheme) ([]SpecCoverage, *specIndex, error) {
+	files, err := s.specFiles(root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	specs := make([]SpecCoverage, 0, len(files))
+	index := newSpecIndex(scheme)
+	for _, file := range files {
+		data, err := s.fs.ReadFile(file.path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("falha ao ler %s: %w", file.path, err)
+		}
+
+		spec := SpecCoverage{File: file.rel}
+		seen := make(map[string]bool)
+		for _, req := range s.validator.Requirements(string(data)) {
+			if seen[req.ID] {
+				continue
+			}
+			seen[req.ID] = true
+			spec.Requirements = append(spec.Requirements, RequirementCoverage{ID: req.ID, Title: req.Title})
+		}
+
+		index.add(file, len(specs))
+		for _, alias := range metadata.Parse(string(data)).Aliases {
+			index.addAlias(alias, len(specs))
+		}
+		specs = append(specs, spec)
+	}
+
+	return specs, index, nil
+}
+
+// scan varre o código-fonte e extrai uma anotação por requisito mencionado
+func (s *Service) scan(root string, specsPath string, extensions []string, re *regexp.Regexp) ([]Annotation, error) {
+	specsAbs, _ := filepath.Abs(specsPath)
+	specIdx := re.SubexpIndex("spec")
+	reqsIdx := re.SubexpIndex("reqs")
+
+	var annotations []Annotation
+	err := s.fs.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			abs, _ := filepath.Abs(path)
+			if path != root && (skippedDirs[info.Name()] || abs == specsAbs) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !hasExtension(path, extensions) {
+			return nil
+		}
+
+		data, err := s.fs.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("falha ao ler %s: %w", path, err)
+		}
+		rel, _ := filepath.Rel(root, path)
+
+		for i, line := range strings.Split(string(data), "\n") {
+			for _, match := range re.FindAllStringSubmatch(line, -1) {
+				for _, id := range requirementIDRegex.FindAllString(match[reqsIdx], -1) {
+					annotations = append(annotations, Annotation{
+						File:        rel,
+						Line:        i + 1,
+						Spec:        match[specIdx],
+						Requirement: id,
+					})
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao varrer código-fonte: %w", err)
+	}
+
+	return annotations, nil
+}
+
+// specIndex resolve a spec de uma anotação pelo nome completo (03-specs-validate),
+// pelo nome sem numeração (specs-validate), pela numeração (03), se única, ou por um
+// nome anterior declarado em aliases (anotações continuam válidas após `specs mv --alias`).
+// Com namespaces de numeração, a numeração pode ser qualificada pelo diretório (api/03).
+type specIndex struct {
+	scheme    numbering.Scheme
+	slugs     map[string][]int // Nome completo; repetido em namespaces diferentes é ambíguo
+	names     map[string][]int
+	numbers   map[string][]int
+	qualified map[string][]int // Numeração qualificada pelo namespace (api/03; 03 na raiz)
+	aliases   map[string][]int
+}
+
+func newSpecIndex(scheme numbering.Scheme) *specIndex {
+	return &specIndex{
+		scheme:    scheme,
+		slugs:     make(map[string][]int),
+		names:     make(map[string][]int),
+		numbers:   make(map[string][]int),
+		qualified: make(map[string][]int),
+		aliases:   make(map[string][]int),
+	}
+}
+
+// addAlias registra um nome anterior da spec (consultado apenas se nenhuma spec atual corresponder)
+func (x *specIndex) addAlias(alias string, idx int) {
+	alias = strings.TrimSuffix(alias, ".spec.md")
+	x.aliases[alias] = append(x.aliases[alias], idx)
+}
+
+func (x *specIndex) add(file specFile, idx int) {
+	x.slugs[file.slug] = append(x.slugs[file.slug], idx)
+	if number, name, ok := x.scheme.Reference(file.slug); ok {
+		x.numbers[number] = append(x.numbers[number], idx)
+		key := numbering.Qualify(x.scheme.Namespace(file.rel), number)
+		x.qualified[key] = append(x.qualified[key], idx)
+		x.names[name] = append(x.names[name], idx)
+	}
+}
+
+// resolve retorna o índice da spec ou -1 e o motivo
+func (x *specIndex) resolve(ref string) (int, string) {
+	ref = strings.TrimSuffix(ref, ".spec.md")
+	// Numeração qualificada (api/03) ou da raiz; se não houver, em qualquer namespace
+	byNumber := x.qualified[ref]
+	if len(byNumber) == 0 {
+		byNumber = x.numbers[ref]
+	}
+	for _, candidates := range [][]int{x.slugs[ref], x.names[ref], byNumber, x.aliases[ref]} {
+		switch len(candidates) {
+		case 0:
+			continue
+		case 1:
+			return candidates[0], ""
+		default:
+			return -1, fmt.Sprintf("spec ambígua: %s corresponde a %d specs", ref, len(candidates))
+		}
+	}
+	return -1, fmt.Sprintf("spec inexistente: %s", ref)
+}
+
+// hasExtension verifica se o arquivo possui uma das extensões
+func hasExtension(path string, extensions []string) bool {
+	for _, ext := range extensions {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// percent calcula percentual inteiro (0 quando total é 0)
+func percent(part, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return part * 100 / total
+}
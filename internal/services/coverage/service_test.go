@@ -0,0 +1,200 @@
+package coverage
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/numbering"
+)
+
+// marker é montado em partes para que este arquivo não seja reconhecido como anotação
+var marker = "spec" + ":"
+
+const coverageSpec = `# 03 - Validate
+
+## 2. Requisitos Funcionais
+- **RF01 - Primeiro:**
+- **RF02 - Segundo:**
+- **RF03 - Terceiro:**
+`
+
+func setupCoverage(t *testing.T, source string) (string, string) {
+	t.Helper()
+	fs := adapters.NewFileSystem()
+
+	root := t.TempDir()
+	specsDir := filepath.Join(root, "specs")
+	srcDir := filepath.Join(root, "src")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	if err := fs.MkdirAll(filepath.Join(srcDir, "vendor"), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	files := map[string]string{
+		filepath.Join(specsDir, "03-specs-validate.spec.md"): coverageSpec,
+		filepath.Join(specsDir, "04-specs-list.spec.md"):     "# 04 - List\n",
+		filepath.Join(srcDir, "validator.go"):                source,
+		filepath.Join(srcDir, "notes.txt"):                   "// " + marker + " 03 RF03\n",
+		filepath.Join(srcDir, "vendor", "lib.go"):            "// " + marker + " 03 RF03\n",
+	}
+	for path, content := range files {
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", path, err)
+		}
+	}
+	return specsDir, srcDir
+}
+
+func TestService_Coverage(t *testing.T) {
+	source := strings.Join([]string{
+		"package validator",
+		"// " + marker + " 03-specs-validate RF01",
+		"// " + marker + " specs-validate RF01, RF02",
+		"// " + marker + " 03 RF09",
+		"// " + marker + " 99-inexistente RF01",
+	}, "\n")
+	specsDir, srcDir := setupCoverage(t, source)
+
+	service := NewService(adapters.NewFileSystem())
+	result, err := service.Coverage(CoverageOptions{SpecsPath: specsDir, SourcePath: srcDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(result.Specs) != 1 {
+		t.Fatalf("esperada apenas a spec com requisitos, obtido %d", len(result.Specs))
+	}
+	spec := result.Specs[0]
+	if spec.Implemented != 2 || spec.Percent != 66 {
+		t.Errorf("esperado 2/3 (66%%), obtido %d/%d (%d%%)", spec.Implemented, len(spec.Requirements), spec.Percent)
+	}
+	if len(spec.Requirements[0].Annotations) != 2 {
+		t.Errorf("RF01 deveria ter 2 anotações, obtido %v", spec.Requirements[0].Annotations)
+	}
+	if spec.Requirements[2].Implemented() {
+		t.Error("RF03 não deveria estar implementado (txt e vendor/ não são varridos)")
+	}
+
+	if result.Annotations != 5 || len(result.Invalid) != 2 {
+		t.Fatalf("esperado 5 anotações e 2 inválidas, obtido %d e %v", result.Annotations, result.Invalid)
+	}
+	if !strings.Contains(result.Invalid[0].Reason, "requisito inexistente") || result.Invalid[0].Line != 4 {
+		t.Errorf("anotação inválida inesperada: %+v", result.Invalid[0])
+	}
+	if !strings.Contains(result.Invalid[1].Reason, "spec inexistente") {
+		t.Errorf("anotação inválida inesperada: %+v", result.Invalid[1])
+	}
+}
+
+func TestService_Coverage_NumberingNamespaces(t *testing.T) {
+	source := strings.Join([]string{
+		"// " + marker + " api/03 RF01",
+		"// " + marker + " 03 RF02",
+	}, "\n")
+	specsDir, srcDir := setupCoverage(t, source)
+	fs := adapters.NewFileSystem()
+	if err := fs.MkdirAll(filepath.Join(specsDir, "api"), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	if err := fs.WriteFile(filepath.Join(specsDir, "api", "03-auth.spec.md"), []byte(coverageSpec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	// api/03 resolve no namespace api; 03 resolve na raiz, mesmo com api/03 existente
+	service := NewService(fs)
+	result, err := service.Coverage(CoverageOptions{
+		SpecsPath:  specsDir,
+		SourcePath: srcDir,
+		Numbering:  numbering.Scheme{Namespaces: []string{"api"}},
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(result.Invalid) != 0 || len(result.Specs) != 2 {
+		t.Fatalf("resultado inesperado: %+v", result)
+	}
+	for _, spec := range result.Specs {
+		implemented := "RF01"
+		if spec.File == "03-specs-validate.spec.md" {
+			implemented = "RF02"
+		}
+		for _, req := range spec.Requirements {
+			if req.Implemented() != (req.ID == implemented) {
+				t.Errorf("%s: %s implementado = %v", spec.File, req.ID, req.Implemented())
+			}
+		}
+	}
+}
+
+func TestService_Coverage_AmbiguousSlug(t *testing.T) {
+	source := strings.Join([]string{
+		"// " + marker + " 03-specs-validate RF01",
+		"// " + marker + " api/03 RF02",
+	}, "\n")
+	specsDir, srcDir := setupCoverage(t, source)
+	fs := adapters.NewFileSystem()
+	if err := fs.MkdirAll(filepath.Join(specsDir, "api"), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	if err := fs.WriteFile(filepath.Join(specsDir, "api", "03-specs-validate.spec.md"), []byte(coverageSpec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	// O mesmo nome em namespaces diferentes não pode ser atribuído a nenhuma das specs
+	service := NewService(fs)
+	result, err := service.Coverage(CoverageOptions{
+		SpecsPath:  specsDir,
+		SourcePath: srcDir,
+		Numbering:  numbering.Scheme{Namespaces: []string{"api"}},
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(result.Invalid) != 1 || !strings.Contains(result.Invalid[0].Reason, "spec ambígua") || result.Invalid[0].Line != 1 {
+		t.Fatalf("esperada anotação ambígua na linha 1, obtido %+v", result.Invalid)
+	}
+	for _, spec := range result.Specs {
+		implemented := ""
+		if spec.File == filepath.Join("api", "03-specs-validate.spec.md") {
+			implemented = "RF02"
+		}
+		for _, req := range spec.Requirements {
+			if req.Implemented() != (req.ID == implemented) {
+				t.Errorf("%s: %s implementado = %v", spec.File, req.ID, req.Implemented())
+			}
+		}
+	}
+}
+
+func TestService_Coverage_CustomPattern(t *testing.T) {
+	specsDir, srcDir := setupCoverage(t, "// implementa 03/RF03\n")
+
+	service := NewService(adapters.NewFileSystem())
+	result, err := service.Coverage(CoverageOptions{
+		SpecsPath:  specsDir,
+		SourcePath: srcDir,
+		Pattern:    `implementa (?P<spec>\d+)/(?P<reqs>RF\d+)`,
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !result.Specs[0].Requirements[2].Implemented() {
+		t.Error("RF03 deveria estar implementado pelo padrão customizado")
+	}
+}
+
+func TestCompilePattern(t *testing.T) {
+	if _, err := CompilePattern(""); err != nil {
+		t.Errorf("padrão padrão deveria compilar: %v", err)
+	}
+	if _, err := CompilePattern(`spec: (\S+)`); err == nil {
+		t.Error("padrão sem grupos nomeados deveria retornar erro")
+	}
+	if _, err := CompilePattern(`(`); err == nil {
+		t.Error("padrão inválido deveria retornar erro")
+	}
+}
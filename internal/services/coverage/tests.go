@@ -0,0 +1,300 @@
+package coverage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
+)
+
+// Situação de um critério de aceite a partir dos resultados de testes
+const (
+	StatusVerified = "verified" // Referenciado por testes e todos passaram
+	StatusFailing  = "failing"  // Ao menos um teste que o referencia falhou
+	StatusUntested = "untested" // Nenhum teste executado o referencia
+)
+
+var (
+	// Marcador em t.Log: `spec: <spec> CA02[, CA03]`
+	criterionMarkerRegex = regexp.MustCompile(`spec:\s*(?P<spec>[\w./-]+)\s+(?P<reqs>CA\d+(?:\s*,\s*CA\d+)*)`)
+	// Nome de teste: TestValidate_Spec03_CA02 (subtestes também são considerados)
+	criterionNameRegex = regexp.MustCompile(`Spec(?P<spec>\d+)_(?P<reqs>CA\d+(?:_CA\d+)*)`)
+	criterionIDRegex   = regexp.MustCompile(`CA\d+`)
+)
+
+// TestCoverageOptions contém opções para cruzar resultados de testes com critérios de aceite
+type TestCoverageOptions struct {
+	SpecsPath string    // Diretório de specs
+	Report    io.Reader // Saída de `go test -json`
+
+	Numbering numbering.Scheme // Esquema de numeração (valor zero = padrão)
+}
+
+// TestReference é a referência de um teste a um critério de aceite
+type TestReference struct {
+	Test      string // pacote.Teste
+	Spec      string // Spec como escrita no nome ou marcador
+	Criterion string
+	Reason    string // Preenchido apenas em referências inválidas
+}
+
+// CriterionCoverage contém a situação de um critério de aceite
+type CriterionCoverage struct {
+	ID       string
+	Text     string
+	Line     int
+	Explicit bool // ID escrito no critério; IDs ordinais mudam quando critérios são inseridos
+	Status   string
+	Tests    []string // Testes que referenciam o critério (pacote.Teste)
+}
+
+// SpecCriteriaCoverage contém a situação dos critérios de aceite de uma spec
+type SpecCriteriaCoverage struct {
+	File     string // Relativo a SpecsPath
+	Criteria []CriterionCoverage
+	Verified int
+	Failing  int
+	Untested int
+	Percent  int // Critérios verificados / total
+}
+
+// TestCoverageResult contém o resultado agregado
+type TestCoverageResult struct {
+	Specs         []SpecCriteriaCoverage
+	Invalid       []TestReference // Referências a specs ou critérios inexistentes
+	Warnings      []string        // Critérios referenciados por testes sem ID explícito
+	Tests         int             // Testes com resultado (pass/fail/skip) no relatório
+	TotalCriteria int
+	Verified      int
+	Failing       int
+	Untested      int
+	Percent       int
+}
+
+// testEvent é um evento emitido por `go test -json` (ver `go doc test2json`)
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Output  string
+}
+
+// testRun agrega os eventos de um teste
+type testRun struct {
+	name   string
+	action string // pass, fail ou skip
+	output strings.Builder
+}
+
+// TestCoverage lê a saída de `go test -json` e classifica cada critério de aceite como
+// verificado, falhando ou sem teste. Testes referenciam critérios pelo nome
+// (TestX_Spec03_CA02) ou por marcadores na saída (t.Log("spec: 03 CA02")).
+func (s *Service) TestCoverage(opts TestCoverageOptions) (*TestCoverageResult, error) {
+	stat, err := s.fs.Stat(opts.SpecsPath)
+	if err != nil {
+		return nil, fmt.Errorf("caminho não existe: %s", opts.SpecsPath)
+	}
+	if !stat.IsDir() {
+		return nil, fmt.Errorf("caminho não é diretório: %s", opts.SpecsPath)
+	}
+
+	runs, err := parseTestEvents(opts.Report)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := s.specFiles(opts.SpecsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := make([]SpecCriteriaCoverage, 0, len(files))
+	index := newSpecIndex(opts.Numbering)
+	for _, file := range files {
+		data, err := s.fs.ReadFile(file.path)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler %s: %w", file.path, err)
+		}
+		spec := SpecCriteriaCoverage{File: file.rel}
+		for _, c := range s.validator.AcceptanceCriteria(string(data)) {
+			spec.Criteria = append(spec.Criteria, CriterionCoverage{ID: c.ID, Text: c.Text, Line: c.Line, Explicit: c.Explicit})
+		}
+		index.add(file, len(specs))
+		for _, alias := range metadata.Parse(string(data)).Aliases {
+			index.addAlias(alias, len(specs))
+		}
+		specs = append(specs, spec)
+	}
+
+	result := &TestCoverageResult{Tests: len(runs)}
+	failing := make(map[*CriterionCoverage]bool)
+	passing := make(map[*CriterionCoverage]bool)
+
+	for _, run := range runs {
+		for _, ref := range testReferences(run) {
+			specIdx, reason := index.resolve(ref.Spec)
+			if specIdx < 0 {
+				ref.Reason = reason
+				result.Invalid = append(result.Invalid, ref)
+				continue
+			}
+			criterion := findCriterion(specs[specIdx].Criteria, ref.Criterion)
+			if criterion == nil {
+				ref.Reason = fmt.Sprintf("critério inexistente em %s", specs[specIdx].File)
+				result.Invalid = append(result.Invalid, ref)
+				continue
+			}
+			if !containsString(criterion.Tests, run.name) {
+				criterion.Tests = append(criterion.Tests, run.name)
+			}
+			switch run.action {
+			case "fail":
+				failing[criterion] = true
+			case "pass":
+				passing[criterion] = true
+			}
+		}
+	}
+
+	for i := range specs {
+		spec := &specs[i]
+		if len(spec.Criteria) == 0 {
+			continue
+		}
+		for j := range spec.Criteria {
+			criterion := &spec.Criteria[j]
+			if len(criterion.Tests) > 0 && !criterion.Explicit {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s:%d: critério %s referenciado por testes sem ID explícito; inserir um critério antes dele muda o ID (escreva **%s** no início do critério)",
+					spec.File, criterion.Line, criterion.ID, criterion.ID))
+			}
+			switch {
+			case failing[criterion]:
+				criterion.Status = StatusFailing
+				spec.Failing++
+			case passing[criterion]:
+				criterion.Status = StatusVerified
+				spec.Verified++
+			default:
+				criterion.Status = StatusUntested
+				spec.Untested++
+			}
+		}
+		spec.Percent = percent(spec.Verified, len(spec.Criteria))
+
+		result.TotalCriteria += len(spec.Criteria)
+		result.Verified += spec.Verified
+		result.Failing += spec.Failing
+		result.Untested += spec.Untested
+		result.Specs = append(result.Specs, *spec)
+	}
+	result.Percent = percent(result.Verified, result.TotalCriteria)
+
+	return result, nil
+}
+
+// parseTestEvents agrega eventos de `go test -json` por teste. Linhas que não são JSON
+// (ex.: mensagens de build) são ignoradas; eventos de pacote (sem Test) também.
+func parseTestEvents(r io.Reader) ([]*testRun, error) {
+	if r == nil {
+		return nil, fmt.Errorf("relatório de testes não informado")
+	}
+
+	runsByName := make(map[string]*testRun)
+	var runs []*testRun
+	events := 0
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+		var event testEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			continue
+		}
+		events++
+		if event.Test == "" {
+			continue
+		}
+
+		name := event.Package + "." + event.Test
+		run, ok := runsByName[name]
+		if !ok {
+			run = &testRun{name: name}
+			runsByName[name] = run
+			runs = append(runs, run)
+		}
+		switch event.Action {
+		case "output":
+			run.output.WriteString(event.Output)
+		case "pass", "fail", "skip":
+			run.action = event.Action
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("falha ao ler relatório de testes: %w", err)
+	}
+	if events == 0 {
+		return nil, fmt.Errorf("relatório de testes vazio ou não está no formato de `go test -json`")
+	}
+
+	// Apenas testes que terminaram (pass, fail ou skip)
+	finished := runs[:0]
+	for _, run := range runs {
+		if run.action != "" {
+			finished = append(finished, run)
+		}
+	}
+	return finished, nil
+}
+
+// testReferences extrai as referências a critérios do nome e da saída de um teste
+func testReferences(run *testRun) []TestReference {
+	var refs []TestReference
+	seen := make(map[string]bool)
+	add := func(spec, ids string) {
+		for _, id := range criterionIDRegex.FindAllString(ids, -1) {
+			key := spec + " " + id
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			refs = append(refs, TestReference{Test: run.name, Spec: spec, Criterion: id})
+		}
+	}
+
+	for _, match := range criterionNameRegex.FindAllStringSubmatch(run.name, -1) {
+		add(match[1], match[2])
+	}
+	for _, match := range criterionMarkerRegex.FindAllStringSubmatch(run.output.String(), -1) {
+		add(match[1], match[2])
+	}
+	return refs
+}
+
+// findCriterion retorna o critério com o ID informado
+func findCriterion(criteria []CriterionCoverage, id string) *CriterionCoverage {
+	for i := range criteria {
+		if criteria[i].ID == id {
+			return &criteria[i]
+		}
+	}
+	return nil
+}
+
+// containsString verifica se o valor está na lista
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
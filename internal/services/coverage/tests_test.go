@@ -0,0 +1,144 @@
+package coverage
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+const criteriaSpec = `# 03 - Validate
+
+## 8. Critérios de Aceite
+
+- [x] Primeiro critério
+- [ ] Segundo critério
+- [ ] Terceiro critério
+- [ ] Quarto critério
+
+## 9. Testes
+`
+
+// testEvents monta a saída de go test -json a partir de linhas "ação teste [saída]"
+func testEvents(lines ...string) string {
+	var b strings.Builder
+	b.WriteString("# github.com/exemplo/pkg\n") // linha que não é JSON
+	for _, line := range lines {
+		parts := strings.SplitN(line, " ", 3)
+		b.WriteString(`{"Action":"run","Package":"pkg","Test":"` + parts[1] + `"}` + "\n")
+		if len(parts) == 3 {
+			b.WriteString(`{"Action":"output","Package":"pkg","Test":"` + parts[1] + `","Output":"` + parts[2] + `\n"}` + "\n")
+		}
+		b.WriteString(`{"Action":"` + parts[0] + `","Package":"pkg","Test":"` + parts[1] + `"}` + "\n")
+	}
+	b.WriteString(`{"Action":"pass","Package":"pkg"}` + "\n")
+	return b.String()
+}
+
+func setupCriteria(t *testing.T) string {
+	t.Helper()
+	fs := adapters.NewFileSystem()
+
+	specsDir := t.TempDir()
+	files := map[string]string{
+		filepath.Join(specsDir, "03-specs-validate.spec.md"): criteriaSpec,
+		filepath.Join(specsDir, "04-specs-list.spec.md"):     "# 04 - List\n",
+	}
+	for path, content := range files {
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", path, err)
+		}
+	}
+	return specsDir
+}
+
+func TestService_TestCoverage(t *testing.T) {
+	specsDir := setupCriteria(t)
+	report := testEvents(
+		"pass TestValidate_Spec03_CA01",
+		"pass TestChecklist "+marker+" specs-validate CA02, CA03",
+		"fail TestChecklist/vazio "+marker+" 03 CA03",
+		"skip TestValidate_Spec03_CA04",
+		"pass TestInvalido_Spec03_CA09",
+		"pass TestOutro "+marker+" 99 CA01",
+	)
+
+	service := NewService(adapters.NewFileSystem())
+	result, err := service.TestCoverage(TestCoverageOptions{SpecsPath: specsDir, Report: strings.NewReader(report)})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(result.Specs) != 1 {
+		t.Fatalf("esperada apenas a spec com critérios, obtido %d", len(result.Specs))
+	}
+	expected := []string{StatusVerified, StatusVerified, StatusFailing, StatusUntested}
+	for i, criterion := range result.Specs[0].Criteria {
+		if criterion.Status != expected[i] {
+			t.Errorf("%s: esperado %s, obtido %s (testes: %v)", criterion.ID, expected[i], criterion.Status, criterion.Tests)
+		}
+	}
+	if tests := result.Specs[0].Criteria[2].Tests; len(tests) != 2 {
+		t.Errorf("CA03 deveria ser referenciado por 2 testes, obtido %v", tests)
+	}
+	if result.Verified != 2 || result.Failing != 1 || result.Untested != 1 || result.Percent != 50 {
+		t.Errorf("resumo inesperado: %+v", result)
+	}
+	if result.Tests != 6 {
+		t.Errorf("esperados 6 testes no relatório, obtido %d", result.Tests)
+	}
+
+	// Critérios sem ID explícito referenciados por testes (inclusive pulados) geram aviso
+	if len(result.Warnings) != 4 || !strings.Contains(result.Warnings[0], "03-specs-validate.spec.md:5: critério CA01") {
+		t.Errorf("avisos de ID ordinal inesperados: %v", result.Warnings)
+	}
+	if len(result.Invalid) != 2 {
+		t.Fatalf("esperadas 2 referências inválidas, obtido %+v", result.Invalid)
+	}
+	if !strings.Contains(result.Invalid[0].Reason, "critério inexistente") {
+		t.Errorf("motivo inesperado: %s", result.Invalid[0].Reason)
+	}
+	if !strings.Contains(result.Invalid[1].Reason, "spec inexistente") {
+		t.Errorf("motivo inesperado: %s", result.Invalid[1].Reason)
+	}
+}
+
+func TestService_TestCoverage_ExplicitIDs(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	specsDir := t.TempDir()
+	spec := "# 03 - Validate\n\n## 8. Critérios de Aceite\n\n- [x] **CA01** Primeiro\n- [ ] **CA05** Inserido depois\n- [ ] **CA02** Segundo\n"
+	if err := fs.WriteFile(filepath.Join(specsDir, "03-specs-validate.spec.md"), []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	service := NewService(fs)
+	result, err := service.TestCoverage(TestCoverageOptions{
+		SpecsPath: specsDir,
+		Report:    strings.NewReader(testEvents("pass TestValidate_Spec03_CA02", "fail TestValidate_Spec03_CA05")),
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	criteria := result.Specs[0].Criteria
+	if criteria[1].ID != "CA05" || criteria[1].Text != "Inserido depois" || criteria[1].Status != StatusFailing {
+		t.Errorf("critério inserido inesperado: %+v", criteria[1])
+	}
+	if criteria[2].ID != "CA02" || criteria[2].Status != StatusVerified {
+		t.Errorf("critério CA02 deveria manter o ID e estar verificado: %+v", criteria[2])
+	}
+	if len(result.Warnings) != 0 || len(result.Invalid) != 0 {
+		t.Errorf("IDs explícitos não deveriam gerar avisos nem referências inválidas: %v %+v", result.Warnings, result.Invalid)
+	}
+}
+
+func TestService_TestCoverage_InvalidReport(t *testing.T) {
+	specsDir := setupCriteria(t)
+	service := NewService(adapters.NewFileSystem())
+
+	_, err := service.TestCoverage(TestCoverageOptions{SpecsPath: specsDir, Report: strings.NewReader("ok  \tpkg\t0.1s\n")})
+	if err == nil {
+		t.Error("esperado erro para relatório fora do formato de go test -json")
+	}
+}
@@ -0,0 +1,447 @@
+package graph
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
+)
+
+// Tipos de aresta do grafo de dependências
+const (
+	EdgeLink      = "link"       // Link markdown para outra spec
+	EdgeDependsOn = "depends_on" // Dependência declarada no frontmatter
+)
+
+var (
+	linkRegex       = regexp.MustCompile(`\[[^\]]*\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	inlineCodeRegex = regexp.MustCompile("`[^`]*`")
+)
+
+// Service constrói o grafo de dependências entre specs
+type Service struct {
+	fs adapters.FileSystem
+}
+
+// NewService cria uma nova instância do Service
+func NewService(fs adapters.FileSystem) *Service {
+	return &Service{fs: fs}
+}
+
+// GraphOptions contém opções para construção do grafo
+type GraphOptions struct {
+	Path      string           // Diretório de specs
+	Numbering numbering.Scheme // Esquema de numeração, para resolver depends_on pela numeração (valor zero = padrão)
+}
+
+// Node representa uma spec no grafo
+type Node struct {
+	ID         string `json:"id"`   // Caminho relativo sem .spec.md (ex.: 03-specs-validate, api/01-auth)
+	File       string `json:"file"` // Relativo ao diretório de specs
+	Title      string `json:"title"`
+	Status     string `json:"status,omitempty"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+// Edge representa uma dependência entre specs (From depende de To)
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+	Line int    `json:"line"` // Linha da referência em From
+}
+
+// Unresolved é uma dependência declarada em depends_on que não corresponde a nenhuma spec
+type Unresolved struct {
+	From   string `json:"from"`
+	Target string `json:"target"`
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// Cycle é um ciclo de dependências; Specs começa e termina na mesma spec
+type Cycle struct {
+	Specs    []string `json:"specs"`
+	Declared bool     `json:"declared"` // Todas as arestas do ciclo vêm de depends_on (ciclos com links podem ser apenas navegação)
+}
+
+// Graph contém specs, dependências e problemas detectados
+type Graph struct {
+	Nodes      []Node       `json:"nodes"`
+	Edges      []Edge       `json:"edges"`
+	Cycles     []Cycle      `json:"cycles"`
+	Deprecated []Edge       `json:"deprecated"` // Dependências de specs ativas para specs obsoletas
+	Unresolved []Unresolved `json:"unresolved"`
+}
+
+// Node retorna o nó com o ID informado
+func (g *Graph) Node(id string) *Node {
+	for i := range g.Nodes {
+		if g.Nodes[i].ID == id {
+			return &g.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// Build lê as specs do diretório e monta o grafo a partir de links e do frontmatter (depends_on)
+func (s *Service) Build(opts GraphOptions) (*Graph, error) {
+	stat, err := s.fs.Stat(opts.Path)
+	if err != nil {
+		return nil, fmt.Errorf("caminho não existe: %s", opts.Path)
+	}
+	if !stat.IsDir() {
+		return nil, fmt.Errorf("caminho não é diretório: %s", opts.Path)
+	}
+
+	files, err := s.findSpecFiles(opts.Path)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
+	}
+	sort.Strings(files)
+
+	g := &Graph{
+		Nodes:      []Node{},
+		Edges:      []Edge{},
+		Cycles:     []Cycle{},
+		Deprecated: []Edge{},
+		Unresolved: []Unresolved{},
+	}
+
+	// Indexar specs por caminho e por nome
+	byPath := make(map[string]string)
+	index := newSpecIndex(opts.Numbering)
+	contents := make(map[string]string)
+	for _, file := range files {
+		data, err := s.fs.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler %s: %w", file, err)
+		}
+		content := string(data)
+		meta := metadata.Parse(content)
+
+		rel, _ := filepath.Rel(opts.Path, file)
+		id := strings.TrimSuffix(filepath.ToSlash(rel), ".spec.md")
+		title := meta.Title
+		if title == "" {
+			title = heading(content, meta.EndLine)
+		}
+
+		byPath[filepath.Clean(file)] = id
+		index.add(strings.TrimSuffix(filepath.Base(file), ".spec.md"), id)
+		for _, alias := range meta.Aliases {
+			index.addAlias(alias, id)
+		}
+		contents[file] = content
+		g.Nodes = append(g.Nodes, Node{
+			ID:         id,
+			File:       rel,
+			Title:      title,
+			Status:     meta.Status,
+			Deprecated: meta.Deprecated(),
+		})
+	}
+
+	// Extrair arestas
+	seen := make(map[string]bool)
+	addEdge := func(e Edge) {
+		key := e.From + "\x00" + e.To + "\x00" + e.Kind
+		if e.From == e.To || seen[key] {
+			return
+		}
+		seen[key] = true
+		g.Edges = append(g.Edges, e)
+	}
+
+	for _, file := range files {
+		content := contents[file]
+		from := byPath[filepath.Clean(file)]
+		meta := metadata.Parse(content)
+
+		for _, target := range meta.DependsOn {
+			to, reason := index.resolve(target, opts.Numbering.Namespace(from))
+			if to == "" {
+				// Dependência também pode ser um caminho relativo à spec
+				if id, ok := byPath[filepath.Join(filepath.Dir(file), target)]; ok {
+					to, reason = id, ""
+				}
+			}
+			if to == "" {
+				g.Unresolved = append(g.Unresolved, Unresolved{From: from, Target: target, Line: meta.Lines["depends_on"], Reason: reason})
+				continue
+			}
+			addEdge(Edge{From: from, To: to, Kind: EdgeDependsOn, Line: meta.Lines["depends_on"]})
+		}
+
+		for _, link := range specLinks(content) {
+			if id, ok := byPath[filepath.Join(filepath.Dir(file), link.target)]; ok {
+				addEdge(Edge{From: from, To: id, Kind: EdgeLink, Line: link.line})
+			}
+		}
+	}
+
+	g.Cycles = findCycles(g)
+
+	// Specs ativas que dependem de specs obsoletas (uma entrada por par de specs)
+	deprecated := make(map[string]bool)
+	reported := make(map[string]bool)
+	for _, n := range g.Nodes {
+		deprecated[n.ID] = n.Deprecated
+	}
+	for _, e := range g.Edges {
+		key := e.From + "\x00" + e.To
+		if deprecated[e.To] && !deprecated[e.From] && !reported[key] {
+			reported[key] = true
+			g.Deprecated = append(g.Deprecated, e)
+		}
+	}
+
+	return g, nil
+}
+
+// findSpecFiles encontra todos os arquivos .spec.md recursivamente
+func (s *Service) findSpecFiles(root string) ([]string, error) {
+	var files []string
+	err := s.fs.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".spec.md") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+// heading retorna o texto do primeiro título principal (#) após o frontmatter
+func heading(content string, start int) string {
+	lines := strings.Split(content, "\n")
+	for _, line := range lines[start:] {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(trimmed, "# "))
+		}
+	}
+	return ""
+}
+
+// specLink é um link markdown para um arquivo .spec.md
+type specLink struct {
+	target string // Caminho sem fragmento (#ancora)
+	line   int
+}
+
+// specLinks extrai links para specs, ignorando blocos de código, código inline e URLs externas
+func specLinks(content string) []specLink {
+	var links []specLink
+	inFence := false
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		line = inlineCodeRegex.ReplaceAllString(line, "")
+		for _, match := range linkRegex.FindAllStringSubmatch(line, -1) {
+			target, _, _ := strings.Cut(match[1], "#")
+			if strings.Contains(target, "://") || !strings.HasSuffix(target, ".spec.md") {
+				continue
+			}
+			links = append(links, specLink{target: target, line: i + 1})
+		}
+	}
+	return links
+}
+
+// findCycles detecta ciclos por busca em profundidade, em ordem determinística.
+// Ciclos só de depends_on são procurados primeiro no subgrafo de depends_on: no grafo
+// completo, a busca pode fechar o ciclo por um caminho com links e nunca registrar o
+// ciclo declarado. Em seguida vêm os ciclos que passam por links.
+// Cada ciclo é rotacionado para começar pela menor spec e reportado uma única vez.
+func findCycles(g *Graph) []Cycle {
+	declared := make(map[string]bool)
+	for _, e := range g.Edges {
+		if e.Kind == EdgeDependsOn {
+			declared[e.From+"\x00"+e.To] = true
+		}
+	}
+
+	cycles := []Cycle{}
+	seen := make(map[string]bool)
+	for _, onlyDeclared := range []bool{true, false} {
+		for _, specs := range searchCycles(g, onlyDeclared) {
+			key := strings.Join(specs, "\x00")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			c := Cycle{Specs: specs, Declared: true}
+			for i := 0; i+1 < len(specs); i++ {
+				if !declared[specs[i]+"\x00"+specs[i+1]] {
+					c.Declared = false
+				}
+			}
+			cycles = append(cycles, c)
+		}
+	}
+	return cycles
+}
+
+// searchCycles retorna os ciclos encontrados pela busca em profundidade nas arestas do grafo
+// (apenas depends_on se onlyDeclared), na forma canônica de canonicalCycle
+func searchCycles(g *Graph, onlyDeclared bool) [][]string {
+	adjacency := make(map[string][]string)
+	for _, e := range g.Edges {
+		if onlyDeclared && e.Kind != EdgeDependsOn {
+			continue
+		}
+		if !containsString(adjacency[e.From], e.To) {
+			adjacency[e.From] = append(adjacency[e.From], e.To)
+		}
+	}
+	for id := range adjacency {
+		sort.Strings(adjacency[id])
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+	var stack []string
+	var cycles [][]string
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, next := range adjacency[id] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				// Aresta de retorno: o ciclo é o trecho da pilha a partir de next
+				start := len(stack) - 1
+				for stack[start] != next {
+					start--
+				}
+				cycles = append(cycles, canonicalCycle(stack[start:]))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = done
+	}
+
+	for _, n := range g.Nodes {
+		if state[n.ID] == unvisited {
+			visit(n.ID)
+		}
+	}
+	return cycles
+}
+
+// canonicalCycle rotaciona o ciclo para começar pela menor spec e repete a primeira no final
+func canonicalCycle(path []string) []string {
+	min := 0
+	for i := range path {
+		if path[i] < path[min] {
+			min = i
+		}
+	}
+	cycle := make([]string, 0, len(path)+1)
+	cycle = append(cycle, path[min:]...)
+	cycle = append(cycle, path[:min]...)
+	return append(cycle, cycle[0])
+}
+
+// specIndex resolve uma dependência pelo nome completo (03-specs-validate, com ou sem
+// .spec.md), pelo nome sem numeração (specs-validate), pela numeração (03), se única,
+// ou por um nome anterior declarado em aliases. Com namespaces de numeração, a numeração
+// pode ser qualificada pelo diretório (api/03).
+type specIndex struct {
+	scheme    numbering.Scheme
+	slugs     map[string][]string
+	names     map[string][]string
+	numbers   map[string][]string
+	qualified map[string][]string // Numeração qualificada pelo namespace (api/03; 03 na raiz)
+	aliases   map[string][]string
+}
+
+func newSpecIndex(scheme numbering.Scheme) *specIndex {
+	return &specIndex{
+		scheme:    scheme,
+		slugs:     make(map[string][]string),
+		names:     make(map[string][]string),
+		numbers:   make(map[string][]string),
+		qualified: make(map[string][]string),
+		aliases:   make(map[string][]string),
+	}
+}
+
+// addAlias registra um nome anterior da spec (consultado apenas se nenhuma spec atual corresponder)
+func (x *specIndex) addAlias(alias string, id string) {
+	alias = strings.TrimSuffix(filepath.Base(alias), ".spec.md")
+	x.aliases[alias] = append(x.aliases[alias], id)
+}
+
+func (x *specIndex) add(slug string, id string) {
+	x.slugs[slug] = append(x.slugs[slug], id)
+	if number, name, ok := x.scheme.Reference(slug); ok {
+		x.numbers[number] = append(x.numbers[number], id)
+		key := numbering.Qualify(x.scheme.Namespace(id), number)
+		x.qualified[key] = append(x.qualified[key], id)
+		x.names[name] = append(x.names[name], id)
+	}
+}
+
+// resolve retorna o ID da spec ou vazio e o motivo. namespace é o namespace de numeração da
+// spec que declara a dependência, onde uma numeração sem diretório é procurada primeiro.
+func (x *specIndex) resolve(ref string, namespace string) (string, string) {
+	ref = strings.TrimSuffix(filepath.ToSlash(ref), ".spec.md")
+	base := path.Base(ref)
+	for _, candidates := range [][]string{x.slugs[base], x.names[base], x.byNumber(ref, namespace), x.aliases[base]} {
+		switch len(candidates) {
+		case 0:
+			continue
+		case 1:
+			return candidates[0], ""
+		default:
+			return "", fmt.Sprintf("spec ambígua: %s corresponde a %d specs", base, len(candidates))
+		}
+	}
+	return "", fmt.Sprintf("spec inexistente: %s", base)
+}
+
+// byNumber retorna as specs com a numeração: no namespace indicado pela referência (api/03) ou,
+// sem diretório, no namespace da spec que referencia; se não houver, em qualquer namespace
+func (x *specIndex) byNumber(ref string, namespace string) []string {
+	if !strings.Contains(ref, "/") {
+		ref = numbering.Qualify(namespace, ref)
+	}
+	if candidates := x.qualified[ref]; len(candidates) > 0 {
+		return candidates
+	}
+	return x.numbers[path.Base(ref)]
+}
+
+// containsString verifica se o valor está na lista
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
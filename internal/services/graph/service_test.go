@@ -0,0 +1,138 @@
+package graph
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func writeSpecs(t *testing.T, files map[string]string) string {
+	t.Helper()
+	fs := adapters.NewFileSystem()
+	specsDir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(specsDir, name)
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("falha ao criar diretório: %v", err)
+		}
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+	return specsDir
+}
+
+func TestService_Build(t *testing.T) {
+	specsDir := writeSpecs(t, map[string]string{
+		"01-a.spec.md":       "---\ndepends_on: [02, specs-c]\n---\n# 01 - A\n[B](02-b.spec.md#dados) [externo](https://x.io/02-b.spec.md)\n```\n[código](03-c.spec.md)\n```\n",
+		"02-b.spec.md":       "# 02 - B\n[A](01-a.spec.md) [api](api/04-d.spec.md)\n",
+		"03-specs-c.spec.md": "---\nstatus: deprecated\ndepends_on: [99]\n---\n# 03 - C\n",
+		"api/04-d.spec.md":   "# 04 - D\n[A](../01-a.spec.md) [quebrado](01-a.spec.md)\n",
+	})
+
+	service := NewService(adapters.NewFileSystem())
+	g, err := service.Build(GraphOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(g.Nodes) != 4 || g.Node("api/04-d") == nil || !g.Node("03-specs-c").Deprecated {
+		t.Fatalf("nós inesperados: %+v", g.Nodes)
+	}
+	if g.Node("01-a").Title != "01 - A" {
+		t.Errorf("título inesperado: %q", g.Node("01-a").Title)
+	}
+
+	var edges []string
+	for _, e := range g.Edges {
+		edges = append(edges, e.From+">"+e.To+":"+e.Kind)
+	}
+	expected := "01-a>02-b:depends_on 01-a>03-specs-c:depends_on 01-a>02-b:link 02-b>01-a:link 02-b>api/04-d:link api/04-d>01-a:link"
+	if strings.Join(edges, " ") != expected {
+		t.Errorf("arestas inesperadas:\n  obtido:   %s\n  esperado: %s", strings.Join(edges, " "), expected)
+	}
+
+	if len(g.Cycles) != 2 {
+		t.Fatalf("esperados 2 ciclos, obtido %+v", g.Cycles)
+	}
+	if strings.Join(g.Cycles[0].Specs, " → ") != "01-a → 02-b → 01-a" {
+		t.Errorf("ciclo inesperado: %v", g.Cycles[0].Specs)
+	}
+	if g.Cycles[0].Declared {
+		t.Error("ciclo com link não deveria ser considerado declarado")
+	}
+
+	if len(g.Deprecated) != 1 || g.Deprecated[0].From != "01-a" || g.Deprecated[0].To != "03-specs-c" {
+		t.Errorf("dependências obsoletas inesperadas: %+v", g.Deprecated)
+	}
+	if len(g.Unresolved) != 1 || g.Unresolved[0].Target != "99" || g.Unresolved[0].Line != 3 {
+		t.Errorf("dependências inexistentes inesperadas: %+v", g.Unresolved)
+	}
+}
+
+func TestService_Build_DeclaredCycle(t *testing.T) {
+	specsDir := writeSpecs(t, map[string]string{
+		"01-a.spec.md": "---\ndepends_on: [03]\n---\n# 01 - A\n",
+		"02-b.spec.md": "---\ndepends_on: [01-a.spec.md]\n---\n# 02 - B\n",
+		"03-c.spec.md": "---\ndepends_on: [b]\n---\n# 03 - C\n",
+	})
+
+	service := NewService(adapters.NewFileSystem())
+	g, err := service.Build(GraphOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(g.Cycles) != 1 || !g.Cycles[0].Declared {
+		t.Fatalf("esperado 1 ciclo declarado, obtido %+v", g.Cycles)
+	}
+	if strings.Join(g.Cycles[0].Specs, " → ") != "01-a → 03-c → 02-b → 01-a" {
+		t.Errorf("ciclo inesperado: %v", g.Cycles[0].Specs)
+	}
+}
+
+func TestService_Build_DeclaredCycleWithLinks(t *testing.T) {
+	// A busca no grafo completo fecha o ciclo por 01-a → 02-b (link) → 03-c (link) antes de
+	// seguir a dependência 01-a → 03-c; o ciclo de depends_on ainda deve ser reportado
+	specsDir := writeSpecs(t, map[string]string{
+		"01-a.spec.md": "---\ndepends_on: [03]\n---\n# 01 - A\n[B](02-b.spec.md)\n",
+		"02-b.spec.md": "# 02 - B\n[C](03-c.spec.md)\n",
+		"03-c.spec.md": "---\ndepends_on: [01]\n---\n# 03 - C\n",
+	})
+
+	service := NewService(adapters.NewFileSystem())
+	g, err := service.Build(GraphOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	var cycles []string
+	for _, c := range g.Cycles {
+		cycles = append(cycles, fmt.Sprintf("%s declared=%v", strings.Join(c.Specs, " → "), c.Declared))
+	}
+	expected := "01-a → 03-c → 01-a declared=true; 01-a → 02-b → 03-c → 01-a declared=false"
+	if got := strings.Join(cycles, "; "); got != expected {
+		t.Errorf("ciclos inesperados:\n  obtido:   %s\n  esperado: %s", got, expected)
+	}
+}
+
+func TestService_Build_Aliases(t *testing.T) {
+	specsDir := writeSpecs(t, map[string]string{
+		"01-a.spec.md": "---\ndepends_on: [02-antiga, 03]\n---\n# 01 - A\n",
+		"03-b.spec.md": "---\naliases: [02-antiga]\n---\n# 03 - B\n",
+	})
+
+	service := NewService(adapters.NewFileSystem())
+	g, err := service.Build(GraphOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	// O alias resolve para a spec atual; a aresta duplicada é descartada
+	if len(g.Unresolved) != 0 || len(g.Edges) != 1 || g.Edges[0].To != "03-b" {
+		t.Errorf("alias não resolvido: arestas %+v, inexistentes %+v", g.Edges, g.Unresolved)
+	}
+}
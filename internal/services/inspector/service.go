@@ -0,0 +1,360 @@
+package inspector
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/baseline"
+	"github.com/dreibox/specs/internal/services/checker"
+	"github.com/dreibox/specs/internal/services/graph"
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
+	"github.com/dreibox/specs/internal/services/validator"
+)
+
+var (
+	headingRegex     = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+	checkboxRegex    = regexp.MustCompile(`^[-*+]\s+\[[ xX]\]`)
+	htmlCommentRegex = regexp.MustCompile(`<!--.*?-->`)
+)
+
+// Service reúne as informações detalhadas de uma spec
+type Service struct {
+	fs           adapters.FileSystem
+	checkerSvc   *checker.Service
+	graphSvc     *graph.Service
+	validatorSvc *validator.Service
+}
+
+// NewService cria uma nova instância do Service
+func NewService(fs adapters.FileSystem) *Service {
+	return &Service{
+		fs:           fs,
+		checkerSvc:   checker.NewService(fs),
+		graphSvc:     graph.NewService(fs),
+		validatorSvc: validator.NewService(fs),
+	}
+}
+
+// InspectOptions contém opções de `specs show`
+type InspectOptions struct {
+	Path     string             // Diretório de specs
+	Spec     string             // Arquivo, nome completo, nome sem numeração, numeração, alias ou parte do nome/título
+	Baseline *baseline.Baseline // Se informado, erros registrados no baseline não são reportados
+
+	Numbering numbering.Scheme // Esquema de numeração (valor zero = padrão de dois dígitos)
+}
+
+// Section é um título da spec (## em diante) com a contagem de palavras do seu conteúdo
+type Section struct {
+	Title string
+	Level int // 2 para ##, 3 para ### ...
+	Line  int
+	Words int // Palavras até o próximo título de mesmo nível ou superior (inclui subseções)
+}
+
+// Link é uma referência entre a spec e outra spec (link markdown ou depends_on)
+type Link struct {
+	ID    string // Outra spec: destino (saída) ou origem (entrada)
+	Title string
+	Kind  string // graph.EdgeLink ou graph.EdgeDependsOn
+	Line  int    // Linha da referência na spec de origem
+}
+
+// InspectResult contém as informações detalhadas de uma spec
+type InspectResult struct {
+	Path    string // Caminho do arquivo
+	ID      string // Caminho relativo ao diretório de specs, sem .spec.md (ex.: api/01-auth)
+	Number  string // Vazio se o nome não segue o esquema de numeração
+	Name    string
+	Title   string
+	Fuzzy   bool // A referência foi resolvida por aproximação (parte do nome ou do título)
+	Lines   int
+	Words   int
+	ModTime time.Time
+
+	Metadata     *metadata.Metadata
+	Sections     []Section
+	Checklist    []validator.ChecklistItem
+	Requirements []validator.Requirement
+
+	Outbound   []Link             // Specs referenciadas por esta spec
+	Inbound    []Link             // Specs que referenciam esta spec
+	Unresolved []graph.Unresolved // Dependências declaradas em depends_on sem spec correspondente
+
+	Validation validator.ValidationResult
+}
+
+// Inspect localiza a spec e reúne metadados, estrutura, checklist, requisitos, links e o
+// resultado da validação. A spec é procurada como em `specs mv` e, sem correspondência
+// exata, por aproximação com o nome e o título das specs.
+func (s *Service) Inspect(opts InspectOptions) (*InspectResult, error) {
+	ref := strings.TrimSpace(opts.Spec)
+	if ref == "" {
+		return nil, fmt.Errorf("informe a spec (numeração, nome ou arquivo)")
+	}
+
+	g, err := s.graphSvc.Build(graph.GraphOptions{Path: opts.Path, Numbering: opts.Numbering})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &InspectResult{}
+	file, err := s.checkerSvc.FindSpec(opts.Path, ref, opts.Numbering)
+	if errors.Is(err, checker.ErrSpecNotFound) {
+		var id string
+		if id, err = fuzzyFind(g, ref, opts.Numbering); err == nil {
+			file = filepath.Join(opts.Path, filepath.FromSlash(g.Node(id).File))
+			result.Fuzzy = true
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.fs.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler %s: %w", file, err)
+	}
+	content := string(data)
+
+	rel, _ := filepath.Rel(opts.Path, file)
+	result.Path = file
+	result.ID = strings.TrimSuffix(filepath.ToSlash(rel), ".spec.md")
+	slug := strings.TrimSuffix(filepath.Base(file), ".spec.md")
+	if n, name, ok := opts.Numbering.Split(slug); ok {
+		result.Number, result.Name = opts.Numbering.Format(n), name
+	} else {
+		result.Name = slug
+	}
+	if node := g.Node(result.ID); node != nil {
+		result.Title = node.Title
+	}
+	if stat, err := s.fs.Stat(file); err == nil {
+		result.ModTime = stat.ModTime()
+	}
+
+	result.Metadata = metadata.Parse(content)
+	result.Lines = strings.Count(strings.TrimSuffix(content, "\n"), "\n") + 1
+	result.Sections, result.Words = outline(metadata.Body(content))
+	result.Checklist = s.validatorSvc.ChecklistItems(content)
+	result.Requirements = s.validatorSvc.Requirements(content)
+
+	for _, e := range g.Edges {
+		switch result.ID {
+		case e.From:
+			result.Outbound = append(result.Outbound, Link{ID: e.To, Title: nodeTitle(g, e.To), Kind: e.Kind, Line: e.Line})
+		case e.To:
+			result.Inbound = append(result.Inbound, Link{ID: e.From, Title: nodeTitle(g, e.From), Kind: e.Kind, Line: e.Line})
+		}
+	}
+	sortLinks(result.Outbound)
+	sortLinks(result.Inbound)
+	for _, u := range g.Unresolved {
+		if u.From == result.ID {
+			result.Unresolved = append(result.Unresolved, u)
+		}
+	}
+
+	validation, err := s.validatorSvc.Validate(validator.ValidateOptions{Path: file, Baseline: opts.Baseline})
+	if err != nil {
+		return nil, err
+	}
+	result.Validation = validation.Results[0]
+
+	return result, nil
+}
+
+// fuzzyFind procura a spec por aproximação: primeiro pelas specs cujo nome ou título contém
+// todas as palavras da referência; sem nenhuma, pelo nome (ou palavra) mais próximo em
+// distância de edição.
+// Mais de uma candidata torna a referência ambígua.
+func fuzzyFind(g *graph.Graph, ref string, scheme numbering.Scheme) (string, error) {
+	words := strings.Fields(normalize(ref))
+
+	var candidates []string
+	for _, n := range g.Nodes {
+		text := normalize(nodeName(n.ID, scheme) + " " + n.Title)
+		matches := len(words) > 0
+		for _, w := range words {
+			if !strings.Contains(text, w) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			candidates = append(candidates, n.ID)
+		}
+	}
+
+	if len(candidates) == 0 {
+		// Erros de digitação: nome (ou palavra do nome ou do título) a até um terço dos
+		// caracteres de distância
+		query := strings.Join(words, " ")
+		best := len([]rune(query))/3 + 1
+		for _, n := range g.Nodes {
+			name := normalize(nodeName(n.ID, scheme))
+			d := editDistance(query, name)
+			for _, w := range strings.Fields(name + " " + normalize(n.Title)) {
+				if dw := editDistance(query, w); dw < d {
+					d = dw
+				}
+			}
+			switch {
+			case d < best:
+				best, candidates = d, []string{n.ID}
+			case d == best && candidates != nil:
+				candidates = append(candidates, n.ID)
+			}
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("%w: %s", checker.ErrSpecNotFound, ref)
+	case 1:
+		return candidates[0], nil
+	}
+	return "", fmt.Errorf("spec ambígua: %s corresponde a %s", ref, strings.Join(candidates, ", "))
+}
+
+// nodeName retorna o nome da spec sem diretório e sem numeração
+func nodeName(id string, scheme numbering.Scheme) string {
+	slug := filepath.Base(filepath.FromSlash(id))
+	if _, name, ok := scheme.Split(slug); ok {
+		return name
+	}
+	return slug
+}
+
+// nodeTitle retorna o título da spec com o ID informado
+func nodeTitle(g *graph.Graph, id string) string {
+	if n := g.Node(id); n != nil {
+		return n.Title
+	}
+	return ""
+}
+
+// sortLinks ordena links pela spec e, na mesma spec, pela linha da referência
+func sortLinks(links []Link) {
+	sort.SliceStable(links, func(i, j int) bool {
+		if links[i].ID != links[j].ID {
+			return links[i].ID < links[j].ID
+		}
+		return links[i].Line < links[j].Line
+	})
+}
+
+// accentReplacer remove acentos comuns em português para comparação aproximada
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a",
+	"é", "e", "ê", "e", "í", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ü", "u", "ç", "c",
+)
+
+// normalize prepara um texto para comparação aproximada: minúsculas, sem acentos e com
+// hífens e sublinhados tratados como espaços
+func normalize(text string) string {
+	text = accentReplacer.Replace(strings.ToLower(text))
+	text = strings.NewReplacer("-", " ", "_", " ").Replace(text)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// editDistance calcula a distância de Levenshtein entre dois textos
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev = curr
+	}
+	return prev[len(rb)]
+}
+
+// outline extrai os títulos a partir do nível 2 (fora de blocos de código) e conta as palavras
+// de cada seção e do corpo da spec. Marcadores de markdown, caixas de checklist e comentários
+// HTML não contam como palavras.
+func outline(body string) ([]Section, int) {
+	var sections []Section
+	var words []int // Palavras de cada linha
+	var headings []int
+	inFence := false
+
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		fence := strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+		if fence {
+			inFence = !inFence
+			words = append(words, 0)
+			continue
+		}
+		if !inFence {
+			if matches := headingRegex.FindStringSubmatch(trimmed); matches != nil {
+				words = append(words, 0)
+				if len(matches[1]) >= 2 {
+					sections = append(sections, Section{Title: matches[2], Level: len(matches[1]), Line: i + 1})
+					headings = append(headings, i)
+				}
+				continue
+			}
+			trimmed = htmlCommentRegex.ReplaceAllString(trimmed, "")
+			trimmed = checkboxRegex.ReplaceAllString(trimmed, "")
+		}
+		words = append(words, countWords(trimmed))
+	}
+
+	total := 0
+	for _, w := range words {
+		total += w
+	}
+	for k := range sections {
+		end := len(lines)
+		for next := k + 1; next < len(sections); next++ {
+			if sections[next].Level <= sections[k].Level {
+				end = headings[next]
+				break
+			}
+		}
+		for i := headings[k] + 1; i < end; i++ {
+			sections[k].Words += words[i]
+		}
+	}
+	return sections, total
+}
+
+// countWords conta as palavras de uma linha, ignorando tokens sem letras ou dígitos
+// (marcadores de lista, separadores de tabela, etc.)
+func countWords(line string) int {
+	count := 0
+	for _, field := range strings.Fields(line) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			count++
+		}
+	}
+	return count
+}
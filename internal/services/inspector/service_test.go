@@ -0,0 +1,162 @@
+package inspector
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/checker"
+)
+
+func writeSpecs(t *testing.T, files map[string]string) string {
+	t.Helper()
+	fs := adapters.NewFileSystem()
+	specsDir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(specsDir, name)
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("falha ao criar diretório: %v", err)
+		}
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+	return specsDir
+}
+
+const authSpec = `---
+status: review
+owner: ana
+tags: [api, segurança]
+depends_on: [02, 99-inexistente]
+---
+# 01 - Autenticação
+
+## 1. Contexto e Objetivo
+
+Login de usuários com <!-- comentário ignorado --> senha.
+
+### Escopo
+
+Apenas login local.
+
+## 2. Requisitos Funcionais
+
+- **RF01 - Login**: usuário entra com senha
+- **RF02 - Logout**: usuário sai
+
+` + "```" + `
+## não é título
+` + "```" + `
+
+## 12. Abertos
+
+### Checklist Rápido
+
+- [x] Contexto claro
+- [ ] Requisitos testáveis
+`
+
+func TestService_Inspect(t *testing.T) {
+	specsDir := writeSpecs(t, map[string]string{
+		"01-autenticacao.spec.md": authSpec,
+		"02-sessoes.spec.md":      "# 02 - Gestão de Sessões\n\nVeja [auth](01-autenticacao.spec.md).\n",
+		"api/03-tokens.spec.md":   "# 03 - Tokens\n\n[auth](../01-autenticacao.spec.md#1-contexto-e-objetivo)\n",
+	})
+
+	service := NewService(adapters.NewFileSystem())
+	result, err := service.Inspect(InspectOptions{Path: specsDir, Spec: "01"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if result.ID != "01-autenticacao" || result.Number != "01" || result.Name != "autenticacao" || result.Fuzzy {
+		t.Errorf("identificação inesperada: %+v", result)
+	}
+	if result.Title != "01 - Autenticação" || result.Metadata.Status != "review" || result.Metadata.Owner != "ana" {
+		t.Errorf("metadados inesperados: título=%q %+v", result.Title, result.Metadata)
+	}
+
+	var outline []string
+	for _, s := range result.Sections {
+		outline = append(outline, fmt.Sprintf("%s %s:%d", strings.Repeat("#", s.Level), s.Title, s.Words))
+	}
+	expected := "## 1. Contexto e Objetivo:8 ### Escopo:3 ## 2. Requisitos Funcionais:13 ## 12. Abertos:4 ### Checklist Rápido:4"
+	if got := strings.Join(outline, " "); got != expected {
+		t.Errorf("estrutura inesperada:\n  obtido:   %s\n  esperado: %s", got, expected)
+	}
+
+	if len(result.Checklist) != 2 || !result.Checklist[0].Checked || result.Checklist[1].Checked ||
+		result.Checklist[1].Text != "Requisitos testáveis" {
+		t.Errorf("checklist inesperado: %+v", result.Checklist)
+	}
+	if len(result.Requirements) != 2 || result.Requirements[1].ID != "RF02" {
+		t.Errorf("requisitos inesperados: %+v", result.Requirements)
+	}
+
+	if len(result.Outbound) != 1 || result.Outbound[0].ID != "02-sessoes" || result.Outbound[0].Title != "02 - Gestão de Sessões" {
+		t.Errorf("links de saída inesperados: %+v", result.Outbound)
+	}
+	if len(result.Inbound) != 2 || result.Inbound[0].ID != "02-sessoes" || result.Inbound[1].ID != "api/03-tokens" {
+		t.Errorf("links de entrada inesperados: %+v", result.Inbound)
+	}
+	if len(result.Unresolved) != 1 || result.Unresolved[0].Target != "99-inexistente" {
+		t.Errorf("dependências não resolvidas inesperadas: %+v", result.Unresolved)
+	}
+
+	if result.Validation.Valid || len(result.Validation.Errors) == 0 || result.Validation.Checklist.ItemCount != 2 {
+		t.Errorf("validação inesperada: %+v", result.Validation)
+	}
+}
+
+func TestService_Inspect_Resolve(t *testing.T) {
+	specsDir := writeSpecs(t, map[string]string{
+		"01-autenticacao.spec.md":   "# 01 - Autenticação\n",
+		"02-sessoes.spec.md":        "# 02 - Gestão de Sessões\n",
+		"03-specs-list.spec.md":     "# 03 - Listagem de Specs\n",
+		"04-specs-validate.spec.md": "---\naliases: [04-validacao]\n---\n# 04 - Validação de Specs\n",
+	})
+	service := NewService(adapters.NewFileSystem())
+
+	tests := []struct {
+		ref   string
+		id    string
+		fuzzy bool
+	}{
+		{"02", "02-sessoes", false},
+		{"sessoes", "02-sessoes", false},
+		{"03-specs-list", "03-specs-list", false},
+		{"04-validacao", "04-specs-validate", false},
+		{filepath.Join(specsDir, "01-autenticacao.spec.md"), "01-autenticacao", false},
+		{"autent", "01-autenticacao", true},
+		{"gestão", "02-sessoes", true},
+		{"Listagem Specs", "03-specs-list", true},
+		{"sesoes", "02-sessoes", true},
+		{"specs-valdate", "04-specs-validate", true},
+		{"autenticaçao", "01-autenticacao", true},
+		{"valdação", "04-specs-validate", true},
+	}
+	for _, tt := range tests {
+		result, err := service.Inspect(InspectOptions{Path: specsDir, Spec: tt.ref})
+		if err != nil {
+			t.Errorf("Inspect(%q) erro inesperado: %v", tt.ref, err)
+			continue
+		}
+		if result.ID != tt.id || result.Fuzzy != tt.fuzzy {
+			t.Errorf("Inspect(%q) = %s (aproximada=%v), esperado %s (aproximada=%v)", tt.ref, result.ID, result.Fuzzy, tt.id, tt.fuzzy)
+		}
+	}
+
+	if _, err := service.Inspect(InspectOptions{Path: specsDir, Spec: "specs"}); err == nil || !strings.Contains(err.Error(), "spec ambígua") {
+		t.Errorf("esperado erro de ambiguidade, obtido %v", err)
+	}
+	if _, err := service.Inspect(InspectOptions{Path: specsDir, Spec: "pagamentos"}); !errors.Is(err, checker.ErrSpecNotFound) {
+		t.Errorf("esperado erro de spec não encontrada, obtido %v", err)
+	}
+	if _, err := service.Inspect(InspectOptions{Path: specsDir, Spec: " "}); err == nil {
+		t.Error("referência vazia deveria retornar erro")
+	}
+}
@@ -0,0 +1,426 @@
+package linkchecker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+// CacheDirName é o subdiretório do cache do usuário onde ficam os caches de links verificados
+const CacheDirName = "specs"
+
+// Valores padrão das opções de verificação
+const (
+	DefaultConcurrency = 8
+	DefaultTimeout     = 10 * time.Second
+	DefaultRetries     = 2
+	DefaultRetryDelay  = 500 * time.Millisecond
+	DefaultHostRate    = 2.0 // Requisições por segundo por host
+	DefaultCacheTTL    = 24 * time.Hour
+)
+
+// Situação de um link externo após a verificação
+const (
+	StatusOK          = "ok"          // Respondeu com 2xx/3xx
+	StatusBroken      = "broken"      // Respondeu com 4xx (exceto 429)
+	StatusUnreachable = "unreachable" // Timeout, erro de rede ou 5xx/429 após as tentativas
+	StatusSkipped     = "skipped"     // Não verificado (lista de bloqueio/permissão ou modo offline)
+)
+
+var (
+	externalLinkRegex = regexp.MustCompile(`\[[^\]]*\]\(\s*<?(https?://[^)\s>]+)>?(?:\s+["'][^)]*["'])?\s*\)|<(https?://[^>\s]+)>`)
+	inlineCodeRegex   = regexp.MustCompile("`[^`]*`")
+)
+
+// Service verifica links externos (http/https)
+type Service struct {
+	fs adapters.FileSystem
+}
+
+// NewService cria uma nova instância do Service
+func NewService(fs adapters.FileSystem) *Service {
+	return &Service{fs: fs}
+}
+
+// Options contém opções da verificação de links externos
+type Options struct {
+	Concurrency int           // Verificações simultâneas (0 = DefaultConcurrency)
+	Timeout     time.Duration // Timeout por requisição (0 = DefaultTimeout)
+	Retries     int           // Novas tentativas após timeout, erro de rede, 429 ou 5xx (0 = DefaultRetries, negativo = nenhuma)
+	RetryDelay  time.Duration // Espera antes da primeira nova tentativa; dobra a cada tentativa (0 = DefaultRetryDelay)
+	HostRate    float64       // Requisições por segundo por host (0 = DefaultHostRate, negativo = sem limite)
+	Allow       []string      // Se informado, apenas URLs que casam com algum padrão são verificadas
+	Deny        []string      // URLs que casam com algum padrão nunca são verificadas
+	CachePath   string        // Arquivo de cache (vazio = sem cache)
+	CacheTTL    time.Duration // Validade de resultados em cache (0 = DefaultCacheTTL)
+	Offline     bool          // Não acessa a rede; usa apenas resultados em cache
+}
+
+// Occurrence é um link externo encontrado em uma spec
+type Occurrence struct {
+	URL  string
+	File string
+	Line int
+}
+
+// Result é o resultado da verificação de uma URL (com todas as suas ocorrências)
+type Result struct {
+	URL         string
+	Status      string
+	StatusCode  int    // Código HTTP da última resposta (0 se não houve resposta)
+	Error       string // Motivo de falha ou de não verificação
+	Attempts    int
+	Cached      bool
+	Occurrences []Occurrence
+}
+
+// ExternalLinks extrai links http/https (links markdown e autolinks <https://...>) de uma spec,
+// ignorando blocos de código e código inline
+func ExternalLinks(file string, content string) []Occurrence {
+	var occurrences []Occurrence
+	inFence := false
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		line = inlineCodeRegex.ReplaceAllString(line, "")
+		for _, match := range externalLinkRegex.FindAllStringSubmatch(line, -1) {
+			link := match[1]
+			if link == "" {
+				link = match[2]
+			}
+			occurrences = append(occurrences, Occurrence{URL: link, File: file, Line: i + 1})
+		}
+	}
+	return occurrences
+}
+
+// Check verifica as URLs das ocorrências (cada URL uma única vez) e retorna os resultados ordenados por URL
+func (s *Service) Check(occurrences []Occurrence, opts Options) ([]Result, error) {
+	opts = withDefaults(opts)
+
+	// Agrupar ocorrências por URL (sem fragmento)
+	byURL := make(map[string]*Result)
+	var urls []string
+	for _, o := range occurrences {
+		key, _, _ := strings.Cut(o.URL, "#")
+		r, ok := byURL[key]
+		if !ok {
+			r = &Result{URL: key}
+			byURL[key] = r
+			urls = append(urls, key)
+		}
+		r.Occurrences = append(r.Occurrences, o)
+	}
+	sort.Strings(urls)
+
+	cache, err := s.loadCache(opts.CachePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Separar o que precisa de acesso à rede
+	var pending []*Result
+	now := time.Now()
+	for _, u := range urls {
+		r := byURL[u]
+		switch {
+		case !allowed(u, opts.Allow, opts.Deny):
+			r.Status = StatusSkipped
+			r.Error = "ignorado pela lista de permissão/bloqueio"
+		case cache.fresh(u, now, opts.CacheTTL):
+			r.Status = StatusOK
+			r.StatusCode = cache.Entries[u].StatusCode
+			r.Cached = true
+		case opts.Offline:
+			r.Status = StatusSkipped
+			r.Error = "não verificado (offline, sem resultado em cache)"
+		default:
+			pending = append(pending, r)
+		}
+	}
+
+	// Verificar concorrentemente, respeitando o limite por host
+	client := &http.Client{Timeout: opts.Timeout}
+	limiter := newHostLimiter(opts.HostRate)
+	jobs := make(chan *Result)
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Concurrency && i < len(pending); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range jobs {
+				s.checkURL(client, limiter, r, opts)
+			}
+		}()
+	}
+	for _, r := range pending {
+		jobs <- r
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Atualizar cache com os links válidos (falhas são sempre verificadas novamente)
+	if opts.CachePath != "" && !opts.Offline && len(pending) > 0 {
+		for _, r := range pending {
+			if r.Status == StatusOK {
+				cache.Entries[r.URL] = cacheEntry{StatusCode: r.StatusCode, CheckedAt: now.UTC()}
+			} else {
+				delete(cache.Entries, r.URL)
+			}
+		}
+		if err := s.saveCache(opts.CachePath, cache); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]Result, 0, len(urls))
+	for _, u := range urls {
+		results = append(results, *byURL[u])
+	}
+	return results, nil
+}
+
+// checkURL verifica uma URL com novas tentativas e espera exponencial.
+// HEAD é tentado primeiro; se o servidor recusar, a verificação é refeita com GET.
+func (s *Service) checkURL(client *http.Client, limiter *hostLimiter, r *Result, opts Options) {
+	host := ""
+	if parsed, err := url.Parse(r.URL); err == nil {
+		host = parsed.Host
+	}
+
+	delay := opts.RetryDelay
+	for attempt := 0; attempt <= opts.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+		r.Attempts++
+
+		limiter.wait(host)
+		code, err := request(client, http.MethodHead, r.URL)
+		if err == nil && code >= 400 {
+			limiter.wait(host)
+			code, err = request(client, http.MethodGet, r.URL)
+		}
+
+		r.StatusCode = code
+		switch {
+		case err != nil:
+			r.Status = StatusUnreachable
+			r.Error = err.Error()
+			continue
+		case code == http.StatusTooManyRequests || code >= 500:
+			r.Status = StatusUnreachable
+			r.Error = fmt.Sprintf("HTTP %d", code)
+			continue
+		case code >= 400:
+			r.Status = StatusBroken
+			r.Error = fmt.Sprintf("HTTP %d", code)
+		default:
+			r.Status = StatusOK
+			r.Error = ""
+		}
+		return
+	}
+}
+
+// request executa a requisição e retorna o código HTTP (redirecionamentos são seguidos)
+func request(client *http.Client, method string, target string) (int, error) {
+	req, err := http.NewRequest(method, target, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", "specs-cli (verificação de links)")
+	resp, err := client.Do(req)
+	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return 0, fmt.Errorf("timeout após %s", client.Timeout)
+		}
+		// Remove o prefixo "Head \"<url>\":" de *url.Error, já que a URL é reportada à parte
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return 0, urlErr.Err
+		}
+		return 0, err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// withDefaults preenche opções não informadas
+func withDefaults(opts Options) Options {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.Retries == 0 {
+		opts.Retries = DefaultRetries
+	} else if opts.Retries < 0 {
+		opts.Retries = 0
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = DefaultRetryDelay
+	}
+	if opts.HostRate == 0 {
+		opts.HostRate = DefaultHostRate
+	}
+	if opts.CacheTTL <= 0 {
+		opts.CacheTTL = DefaultCacheTTL
+	}
+	return opts
+}
+
+// allowed aplica as listas de bloqueio e permissão. Padrões com "://" são prefixos de URL
+// (ex.: https://github.com/org/); os demais são globs de host (ex.: *.example.com, localhost).
+func allowed(target string, allow []string, deny []string) bool {
+	if matchesAny(target, deny) {
+		return false
+	}
+	return len(allow) == 0 || matchesAny(target, allow)
+}
+
+// matchesAny verifica se a URL casa com algum dos padrões
+func matchesAny(target string, patterns []string) bool {
+	host := ""
+	if parsed, err := url.Parse(target); err == nil {
+		host = parsed.Hostname()
+	}
+	for _, pattern := range patterns {
+		if strings.Contains(pattern, "://") {
+			if strings.HasPrefix(target, pattern) {
+				return true
+			}
+			continue
+		}
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(host)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// hostLimiter espaça requisições ao mesmo host conforme a taxa máxima
+type hostLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     map[string]time.Time
+}
+
+func newHostLimiter(rate float64) *hostLimiter {
+	l := &hostLimiter{next: make(map[string]time.Time)}
+	if rate > 0 {
+		l.interval = time.Duration(float64(time.Second) / rate)
+	}
+	return l
+}
+
+// wait bloqueia até que uma nova requisição ao host seja permitida
+func (l *hostLimiter) wait(host string) {
+	if l.interval <= 0 {
+		return
+	}
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[host] = at.Add(l.interval)
+	l.mu.Unlock()
+	time.Sleep(time.Until(at))
+}
+
+// cacheEntry é um link verificado com sucesso
+type cacheEntry struct {
+	StatusCode int       `json:"status_code"`
+	CheckedAt  time.Time `json:"checked_at"`
+}
+
+// linkCache contém links verificados com sucesso
+type linkCache struct {
+	Version int                   `json:"version"`
+	Entries map[string]cacheEntry `json:"entries"`
+}
+
+// fresh indica se a URL foi verificada com sucesso dentro da validade
+func (c *linkCache) fresh(target string, now time.Time, ttl time.Duration) bool {
+	entry, ok := c.Entries[target]
+	return ok && now.Sub(entry.CheckedAt) < ttl
+}
+
+// CachePath retorna o arquivo de cache de links de um diretório de specs. O cache fica no
+// diretório de cache do usuário (os.UserCacheDir), fora da árvore versionada, com um arquivo por
+// diretório de specs.
+func CachePath(specsPath string) (string, error) {
+	absPath, err := filepath.Abs(specsPath)
+	if err != nil {
+		return "", fmt.Errorf("falha ao resolver caminho: %w", err)
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("diretório de cache indisponível: %w", err)
+	}
+	sum := sha256.Sum256([]byte(absPath))
+	name := fmt.Sprintf("links-%s.json", hex.EncodeToString(sum[:8]))
+	return filepath.Join(cacheDir, CacheDirName, name), nil
+}
+
+// loadCache carrega o cache; arquivo inexistente resulta em cache vazio
+func (s *Service) loadCache(cachePath string) (*linkCache, error) {
+	cache := &linkCache{Version: 1, Entries: make(map[string]cacheEntry)}
+	if cachePath == "" || !s.fs.Exists(cachePath) {
+		return cache, nil
+	}
+	data, err := s.fs.ReadFile(cachePath)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler cache de links: %w", err)
+	}
+	if err := json.Unmarshal(data, cache); err != nil {
+		return nil, fmt.Errorf("cache de links inválido (%s): %w", cachePath, err)
+	}
+	if cache.Entries == nil {
+		cache.Entries = make(map[string]cacheEntry)
+	}
+	return cache, nil
+}
+
+// saveCache grava o cache (chaves ordenadas pelo encoding/json)
+func (s *Service) saveCache(cachePath string, cache *linkCache) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("falha ao gerar cache de links: %w", err)
+	}
+	if err := s.fs.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return fmt.Errorf("falha ao criar diretório do cache: %w", err)
+	}
+	if err := s.fs.WriteFile(cachePath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("falha ao gravar cache de links: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+package linkchecker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+// newStub cria um servidor HTTP local: /ok responde 200, /missing 404, /no-head recusa HEAD,
+// /flaky falha com 503 na primeira tentativa, /down sempre responde 503 e /slow demora mais que o timeout dos testes
+func newStub(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	var flaky int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/flaky":
+			if atomic.AddInt32(&flaky, 1) <= 2 { // HEAD e GET da primeira tentativa
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/down":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/slow":
+			time.Sleep(300 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+// testOptions são opções rápidas para testes (sem limite por host e sem espera entre tentativas longas)
+func testOptions() Options {
+	return Options{
+		Timeout:    100 * time.Millisecond,
+		Retries:    1,
+		RetryDelay: time.Millisecond,
+		HostRate:   -1,
+	}
+}
+
+func TestExternalLinks(t *testing.T) {
+	content := "# 01 - A\n" +
+		"Veja [docs](https://example.com/docs#uso \"título\") e <http://example.org/x>.\n" +
+		"[local](02-b.spec.md) `[código](https://code.example.com)`\n" +
+		"```\n[bloco](https://block.example.com)\n```\n"
+
+	occurrences := ExternalLinks("01-a.spec.md", content)
+	if len(occurrences) != 2 {
+		t.Fatalf("esperadas 2 ocorrências, obtido %+v", occurrences)
+	}
+	if occurrences[0].URL != "https://example.com/docs#uso" || occurrences[0].Line != 2 || occurrences[0].File != "01-a.spec.md" {
+		t.Errorf("ocorrência inesperada: %+v", occurrences[0])
+	}
+	if occurrences[1].URL != "http://example.org/x" {
+		t.Errorf("autolink não extraído: %+v", occurrences[1])
+	}
+}
+
+func TestService_Check_Statuses(t *testing.T) {
+	server, _ := newStub(t)
+	service := NewService(adapters.NewFileSystem())
+
+	occurrences := []Occurrence{
+		{URL: server.URL + "/ok#secao", File: "01-a.spec.md", Line: 1},
+		{URL: server.URL + "/ok", File: "02-b.spec.md", Line: 3},
+		{URL: server.URL + "/missing", File: "01-a.spec.md", Line: 2},
+		{URL: server.URL + "/no-head", File: "01-a.spec.md", Line: 4},
+		{URL: server.URL + "/flaky", File: "01-a.spec.md", Line: 5},
+		{URL: server.URL + "/slow", File: "01-a.spec.md", Line: 6},
+	}
+	results, err := service.Check(occurrences, testOptions())
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	byPath := make(map[string]Result)
+	for _, r := range results {
+		byPath[strings.TrimPrefix(r.URL, server.URL)] = r
+	}
+	if len(byPath) != 5 {
+		t.Fatalf("esperadas 5 URLs distintas (fragmento ignorado), obtido %+v", results)
+	}
+	if r := byPath["/ok"]; r.Status != StatusOK || len(r.Occurrences) != 2 {
+		t.Errorf("/ok: esperado ok com 2 ocorrências, obtido %+v", r)
+	}
+	if r := byPath["/missing"]; r.Status != StatusBroken || r.StatusCode != 404 || r.Attempts != 1 {
+		t.Errorf("/missing: esperado quebrado sem novas tentativas, obtido %+v", r)
+	}
+	if r := byPath["/no-head"]; r.Status != StatusOK {
+		t.Errorf("/no-head: esperado ok via GET, obtido %+v", r)
+	}
+	if r := byPath["/flaky"]; r.Status != StatusOK || r.Attempts != 2 {
+		t.Errorf("/flaky: esperado ok na segunda tentativa, obtido %+v", r)
+	}
+	if r := byPath["/slow"]; r.Status != StatusUnreachable || !strings.Contains(r.Error, "timeout") || r.Attempts != 2 {
+		t.Errorf("/slow: esperado timeout após 2 tentativas, obtido %+v", r)
+	}
+}
+
+func TestService_Check_Retries(t *testing.T) {
+	server, _ := newStub(t)
+	service := NewService(adapters.NewFileSystem())
+	occurrences := []Occurrence{{URL: server.URL + "/down", File: "01-a.spec.md", Line: 1}}
+
+	tests := []struct {
+		retries  int
+		attempts int
+	}{
+		{0, 1 + DefaultRetries}, // Não informado: padrão
+		{-1, 1},                 // Negativo: nenhuma nova tentativa
+		{1, 2},
+	}
+	for _, tt := range tests {
+		opts := testOptions()
+		opts.Retries = tt.retries
+		results, err := service.Check(occurrences, opts)
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if len(results) != 1 || results[0].Status != StatusUnreachable || results[0].Attempts != tt.attempts {
+			t.Errorf("Retries=%d: esperadas %d tentativas, obtido %+v", tt.retries, tt.attempts, results)
+		}
+	}
+}
+
+func TestService_Check_AllowDeny(t *testing.T) {
+	server, requests := newStub(t)
+	service := NewService(adapters.NewFileSystem())
+
+	opts := testOptions()
+	opts.Deny = []string{server.URL + "/missing"}
+	opts.Allow = []string{"127.0.0.1", "localhost"}
+	results, err := service.Check([]Occurrence{
+		{URL: server.URL + "/missing"},
+		{URL: server.URL + "/ok"},
+		{URL: "https://example.invalid/x"},
+	}, opts)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	statuses := make(map[string]string)
+	for _, r := range results {
+		statuses[strings.TrimPrefix(r.URL, server.URL)] = r.Status
+	}
+	if statuses["/missing"] != StatusSkipped || statuses["https://example.invalid/x"] != StatusSkipped || statuses["/ok"] != StatusOK {
+		t.Errorf("situações inesperadas: %v", statuses)
+	}
+	if atomic.LoadInt32(requests) != 1 {
+		t.Errorf("esperada 1 requisição, obtido %d", atomic.LoadInt32(requests))
+	}
+}
+
+func TestService_Check_Cache(t *testing.T) {
+	server, requests := newStub(t)
+	service := NewService(adapters.NewFileSystem())
+
+	opts := testOptions()
+	opts.CachePath = filepath.Join(t.TempDir(), "links.json")
+	occurrences := []Occurrence{{URL: server.URL + "/ok"}, {URL: server.URL + "/missing"}}
+
+	if _, err := service.Check(occurrences, opts); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	first := atomic.LoadInt32(requests)
+
+	// Segunda execução: /ok vem do cache; /missing (falha) é verificado novamente
+	results, err := service.Check(occurrences, opts)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !results[1].Cached || results[1].Status != StatusOK {
+		t.Errorf("esperado /ok em cache, obtido %+v", results[1])
+	}
+	if results[0].Cached || results[0].Status != StatusBroken {
+		t.Errorf("falhas não devem ir para o cache, obtido %+v", results[0])
+	}
+	if got := atomic.LoadInt32(requests) - first; got != 2 {
+		t.Errorf("esperadas 2 requisições (HEAD e GET de /missing), obtido %d", got)
+	}
+
+	// Offline: usa apenas o cache
+	opts.Offline = true
+	results, err = service.Check(append(occurrences, Occurrence{URL: server.URL + "/new"}), opts)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	for _, r := range results {
+		expected := StatusSkipped
+		if strings.HasSuffix(r.URL, "/ok") {
+			expected = StatusOK
+		}
+		if r.Status != expected {
+			t.Errorf("offline: %s esperado %s, obtido %s", r.URL, expected, r.Status)
+		}
+	}
+
+	// Cache expirado: verifica novamente
+	opts.Offline = false
+	opts.CacheTTL = time.Nanosecond
+	results, err = service.Check(occurrences[:1], opts)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if results[0].Cached {
+		t.Errorf("cache expirado não deveria ser usado: %+v", results[0])
+	}
+}
+
+func TestCachePath(t *testing.T) {
+	cacheHome := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", cacheHome)
+	t.Setenv("HOME", t.TempDir())
+
+	server, _ := newStub(t)
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+	specsDir := t.TempDir()
+
+	cachePath, err := CachePath(specsDir)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !strings.HasPrefix(cachePath, filepath.Join(cacheHome, CacheDirName)+string(filepath.Separator)) {
+		t.Errorf("cache deveria ficar no diretório de cache do usuário, obtido %s", cachePath)
+	}
+	if other, _ := CachePath(t.TempDir()); other == cachePath {
+		t.Error("diretórios de specs diferentes deveriam ter caches diferentes")
+	}
+
+	opts := testOptions()
+	opts.CachePath = cachePath
+	if _, err := service.Check([]Occurrence{{URL: server.URL + "/ok"}}, opts); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !fs.Exists(cachePath) {
+		t.Errorf("cache não gravado em %s", cachePath)
+	}
+	entries, err := os.ReadDir(specsDir)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("nada deveria ser gravado no diretório de specs, obtido %d arquivo(s)", len(entries))
+	}
+}
+
+func TestService_Check_HostRate(t *testing.T) {
+	server, _ := newStub(t)
+	service := NewService(adapters.NewFileSystem())
+
+	opts := testOptions()
+	opts.HostRate = 20 // Uma requisição a cada 50ms
+	var occurrences []Occurrence
+	for _, p := range []string{"/ok", "/ok?a", "/ok?b", "/ok?c"} {
+		occurrences = append(occurrences, Occurrence{URL: server.URL + p})
+	}
+
+	start := time.Now()
+	if _, err := service.Check(occurrences, opts); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("esperado espaçamento por host (>= 150ms para 4 requisições), obtido %s", elapsed)
+	}
+}
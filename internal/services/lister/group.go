@@ -0,0 +1,120 @@
+package lister
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Critérios de agrupamento aceitos em ListOptions.GroupBy
+const (
+	GroupDir    = "dir"
+	GroupTag    = "tag"
+	GroupStatus = "status"
+	GroupOwner  = "owner"
+)
+
+// GroupCriteria lista os critérios de agrupamento, na ordem exibida em mensagens de ajuda
+var GroupCriteria = []string{GroupDir, GroupTag, GroupStatus, GroupOwner}
+
+// Group contém as specs de um grupo e seus totais
+type Group struct {
+	Key        string     `json:"key"` // Diretório, tag, situação ou responsável ("" = sem valor / raiz)
+	Specs      []SpecInfo `json:"specs"`
+	Total      int        `json:"total"`
+	Complete   int        `json:"complete"`
+	Incomplete int        `json:"incomplete"`
+	WithErrors int        `json:"with_errors"`
+	Progress   int        `json:"progress"` // Percentual de itens do checklist marcados no grupo (0 a 100)
+}
+
+// ValidateGroupBy verifica se o critério de agrupamento é conhecido
+func ValidateGroupBy(by string) error {
+	for _, c := range GroupCriteria {
+		if c == by {
+			return nil
+		}
+	}
+	return fmt.Errorf("critério de agrupamento inválido: %s (use %s)", by, strings.Join(GroupCriteria, ", "))
+}
+
+// GroupKeys retorna as chaves de grupo de uma spec para o critério informado. Uma spec
+// com várias tags pertence a um grupo por tag; "" indica ausência do valor (ou a raiz, em dir).
+func GroupKeys(by, dir, lifecycle, owner string, tags []string) []string {
+	switch by {
+	case GroupDir:
+		return []string{dir}
+	case GroupStatus:
+		return []string{strings.ToLower(lifecycle)}
+	case GroupOwner:
+		return []string{owner}
+	case GroupTag:
+		if len(tags) == 0 {
+			return []string{""}
+		}
+		seen := make(map[string]bool, len(tags))
+		var keys []string
+		for _, tag := range tags {
+			if !seen[tag] {
+				seen[tag] = true
+				keys = append(keys, tag)
+			}
+		}
+		return keys
+	}
+	return nil
+}
+
+// SortGroupKeys ordena chaves de grupo alfabeticamente. A chave vazia vem por último
+// (specs sem o metadado), exceto em GroupDir, em que representa a raiz e vem primeiro.
+func SortGroupKeys(by string, keys []string) {
+	emptyFirst := by == GroupDir
+	sort.Slice(keys, func(i, j int) bool {
+		if (keys[i] == "") != (keys[j] == "") {
+			return (keys[i] == "") == emptyFirst
+		}
+		return keys[i] < keys[j]
+	})
+}
+
+// groupSpecs agrupa as specs (mantendo a ordem da listagem dentro de cada grupo) e
+// calcula os totais de cada grupo
+func groupSpecs(specs []SpecInfo, by string) []Group {
+	index := make(map[string]*Group)
+	var keys []string
+	for _, spec := range specs {
+		for _, key := range GroupKeys(by, spec.Dir, spec.Lifecycle, spec.Owner, spec.Tags) {
+			group, ok := index[key]
+			if !ok {
+				group = &Group{Key: key}
+				index[key] = group
+				keys = append(keys, key)
+			}
+			group.Specs = append(group.Specs, spec)
+		}
+	}
+	SortGroupKeys(by, keys)
+
+	groups := make([]Group, 0, len(keys))
+	for _, key := range keys {
+		group := index[key]
+		marked, items := 0, 0
+		for _, spec := range group.Specs {
+			group.Total++
+			if spec.HasErrors {
+				group.WithErrors++
+			} else if spec.Complete {
+				group.Complete++
+			} else {
+				group.Incomplete++
+			}
+			marked += spec.Checklist.MarkedCount
+			items += spec.Checklist.ItemCount
+		}
+		if items > 0 {
+			group.Progress = marked * 100 / items
+		}
+		groups = append(groups, *group)
+	}
+	return groups
+}
@@ -0,0 +1,323 @@
+package lister
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Query é uma expressão de consulta compilada, usada para filtrar specs.
+//
+// Gramática (palavras-chave e campos não diferenciam maiúsculas de minúsculas):
+//
+//	expr    = termo { "or" termo }
+//	termo   = fator { "and" fator }
+//	fator   = "not" fator | "(" expr ")" | campo operador valor
+//	operador = "=" | "!=" | "~" | "!~" | "<" | "<=" | ">" | ">="
+//
+// Exemplos: `status=review and owner=ana`, `tag=cli or (progress<50 and not dir=legado)`.
+// Valores com espaços ou caracteres especiais podem ser escritos entre aspas.
+type Query struct {
+	root queryNode
+}
+
+// Campos aceitos em expressões de consulta
+var queryFields = []string{"number", "name", "title", "dir", "tag", "owner", "status", "validation", "progress", "text"}
+
+// ParseQuery compila uma expressão de consulta
+func ParseQuery(expr string) (*Query, error) {
+	tokens, err := tokenizeQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("expressão de consulta vazia")
+	}
+
+	p := &queryParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("expressão de consulta inválida: token inesperado %q", p.tokens[p.pos].text)
+	}
+	return &Query{root: root}, nil
+}
+
+// Match indica se a spec satisfaz a expressão
+func (q *Query) Match(spec SpecInfo) bool {
+	return q.root.match(spec)
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOperator
+	tokenOpen
+	tokenClose
+)
+
+type queryToken struct {
+	kind tokenKind
+	text string
+}
+
+// tokenizeQuery separa a expressão em palavras, valores entre aspas, operadores e parênteses
+func tokenizeQuery(expr string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n':
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{tokenOpen, "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{tokenClose, ")"})
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("expressão de consulta inválida: aspas não fechadas")
+			}
+			tokens = append(tokens, queryToken{tokenString, string(runes[i+1 : end])})
+			i = end + 1
+		case strings.ContainsRune("=!<>~", r):
+			end := i + 1
+			for end < len(runes) && strings.ContainsRune("=!<>~", runes[end]) {
+				end++
+			}
+			tokens = append(tokens, queryToken{tokenOperator, string(runes[i:end])})
+			i = end
+		default:
+			end := i
+			for end < len(runes) && !strings.ContainsRune(" \t\n()\"'=!<>~", runes[end]) {
+				end++
+			}
+			tokens = append(tokens, queryToken{tokenWord, string(runes[i:end])})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// queryParser implementa um parser descendente recursivo sobre os tokens
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peekKeyword(keyword string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenWord && strings.EqualFold(p.tokens[p.pos].text, keyword)
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseUnary() (queryNode, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("expressão de consulta inválida: fim inesperado")
+	}
+	if p.peekKeyword("not") {
+		p.pos++
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{inner}, nil
+	}
+	if p.tokens[p.pos].kind == tokenOpen {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenClose {
+			return nil, fmt.Errorf("expressão de consulta inválida: parêntese não fechado")
+		}
+		p.pos++
+		return inner, nil
+	}
+	return p.parseCondition()
+}
+
+func (p *queryParser) parseCondition() (queryNode, error) {
+	if p.pos+2 >= len(p.tokens) {
+		return nil, fmt.Errorf("expressão de consulta inválida: condição incompleta após %q", p.tokens[p.pos].text)
+	}
+	field, op, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if field.kind != tokenWord {
+		return nil, fmt.Errorf("expressão de consulta inválida: campo esperado, encontrado %q", field.text)
+	}
+	name := strings.ToLower(field.text)
+	known := false
+	for _, f := range queryFields {
+		if f == name {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return nil, fmt.Errorf("campo de consulta desconhecido: %s (use %s)", field.text, strings.Join(queryFields, ", "))
+	}
+	if op.kind != tokenOperator {
+		return nil, fmt.Errorf("expressão de consulta inválida: operador esperado após %q", field.text)
+	}
+	switch op.text {
+	case "=", "!=", "~", "!~", "<", "<=", ">", ">=":
+	default:
+		return nil, fmt.Errorf("expressão de consulta inválida: operador desconhecido %q", op.text)
+	}
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, fmt.Errorf("expressão de consulta inválida: valor esperado após %s%s", field.text, op.text)
+	}
+	p.pos += 3
+	return conditionNode{field: name, op: op.text, value: value.text}, nil
+}
+
+// queryNode é um nó da árvore de expressão
+type queryNode interface {
+	match(spec SpecInfo) bool
+}
+
+type andNode struct{ left, right queryNode }
+
+func (n andNode) match(spec SpecInfo) bool { return n.left.match(spec) && n.right.match(spec) }
+
+type orNode struct{ left, right queryNode }
+
+func (n orNode) match(spec SpecInfo) bool { return n.left.match(spec) || n.right.match(spec) }
+
+type notNode struct{ inner queryNode }
+
+func (n notNode) match(spec SpecInfo) bool { return !n.inner.match(spec) }
+
+// conditionNode compara um campo da spec com um valor
+type conditionNode struct {
+	field string
+	op    string
+	value string
+}
+
+func (n conditionNode) match(spec SpecInfo) bool {
+	switch n.field {
+	case "tag":
+		// Campos multivalorados: "=" e "~" casam se algum valor casar; "!=" e "!~" se nenhum casar
+		switch n.op {
+		case "!=":
+			return !(conditionNode{n.field, "=", n.value}).match(spec)
+		case "!~":
+			return !(conditionNode{n.field, "~", n.value}).match(spec)
+		}
+		for _, tag := range spec.Tags {
+			if compareValues(tag, n.op, n.value) {
+				return true
+			}
+		}
+		return false
+	case "text":
+		contains := spec.matchesText(n.value)
+		switch n.op {
+		case "=", "~":
+			return contains
+		case "!=", "!~":
+			return !contains
+		}
+		return false
+	}
+	return compareValues(fieldValue(spec, n.field), n.op, n.value)
+}
+
+// fieldValue retorna o valor textual de um campo escalar da spec
+func fieldValue(spec SpecInfo, field string) string {
+	switch field {
+	case "number":
+		return spec.Number
+	case "name":
+		return spec.Name
+	case "title":
+		return spec.Title
+	case "dir":
+		return spec.Dir
+	case "owner":
+		return spec.Owner
+	case "status":
+		return spec.Lifecycle
+	case "validation":
+		return spec.Validation()
+	case "progress":
+		return strconv.Itoa(spec.Progress)
+	}
+	return ""
+}
+
+// compareValues aplica o operador sem diferenciar maiúsculas de minúsculas. Comparações de
+// ordem usam valor numérico quando ambos os lados são números (ex.: progress>=50, number<10).
+func compareValues(actual, op, expected string) bool {
+	a, b := strings.ToLower(actual), strings.ToLower(expected)
+	switch op {
+	case "~":
+		return strings.Contains(a, b)
+	case "!~":
+		return !strings.Contains(a, b)
+	}
+
+	cmp := strings.Compare(a, b)
+	if x, errX := strconv.Atoi(a); errX == nil {
+		if y, errY := strconv.Atoi(b); errY == nil {
+			cmp = x - y
+		}
+	}
+	switch op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
@@ -6,8 +6,11 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
 	"github.com/dreibox/specs/internal/services/validator"
 )
 
@@ -25,33 +28,93 @@ func NewService(fs adapters.FileSystem) *Service {
 	}
 }
 
+// Critérios de ordenação aceitos em ListOptions.Sort
+const (
+	SortNumber   = "number"
+	SortName     = "name"
+	SortModTime  = "mtime"
+	SortProgress = "progress"
+	SortStatus   = "status"
+)
+
+// SortCriteria lista os critérios de ordenação, na ordem exibida em mensagens de ajuda
+var SortCriteria = []string{SortNumber, SortName, SortModTime, SortProgress, SortStatus}
+
 // ListOptions contém opções para listagem
 type ListOptions struct {
 	Path       string
 	Complete   bool
 	Incomplete bool
 	Errors     bool
+	Numbering  numbering.Scheme // Esquema de numeração (valor zero = padrão de dois dígitos)
+
+	Sort    string // Critério de ordenação (SortNumber quando vazio)
+	Reverse bool   // Inverte a ordenação (empates continuam em ordem de numeração)
+
+	Dir    string // Apenas specs deste subdiretório, relativo a Path (inclui subdiretórios)
+	Tag    string // Apenas specs com esta tag
+	Owner  string // Apenas specs deste responsável
+	Status string // Apenas specs nesta situação de ciclo de vida (chave status do frontmatter)
+	Text   string // Apenas specs cujo número, nome, título, metadados ou conteúdo contenham o texto
+	Query  string // Expressão de consulta (ver ParseQuery)
+
+	GroupBy string // Critério de agrupamento (GroupDir, GroupTag, GroupStatus ou GroupOwner); vazio = sem grupos
 }
 
 // SpecInfo contém informações sobre uma spec
 type SpecInfo struct {
-	Path       string
-	Number     string
-	Name       string
-	Status     string
-	StatusIcon string
-	Complete   bool
-	HasErrors  bool
-	Checklist  validator.ChecklistInfo
+	Path       string                  `json:"path"`
+	Number     string                  `json:"number"`
+	Name       string                  `json:"name"`
+	Status     string                  `json:"status"`
+	StatusIcon string                  `json:"status_icon"`
+	Complete   bool                    `json:"complete"`
+	HasErrors  bool                    `json:"has_errors"`
+	Checklist  validator.ChecklistInfo `json:"checklist"`
+
+	Dir       string    `json:"dir"`       // Diretório da spec relativo ao caminho listado ("" = raiz)
+	Title     string    `json:"title"`     // Título do frontmatter ou, na falta dele, do cabeçalho principal
+	Lifecycle string    `json:"lifecycle"` // Situação do ciclo de vida (chave status do frontmatter)
+	Owner     string    `json:"owner"`     // Responsável (chave owner do frontmatter)
+	Tags      []string  `json:"tags"`      // Tags (chave tags do frontmatter)
+	ModTime   time.Time `json:"mod_time"`  // Data de modificação do arquivo
+	Progress  int       `json:"progress"`  // Percentual de itens do checklist marcados (0 a 100)
+
+	text string // Conteúdo em minúsculas, usado na busca textual
+}
+
+// Validation retorna o resultado da validação em forma de palavra-chave: erro, completa ou incompleta
+func (s SpecInfo) Validation() string {
+	if s.HasErrors {
+		return "erro"
+	}
+	if s.Complete {
+		return "completa"
+	}
+	return "incompleta"
+}
+
+// matchesText indica se o texto aparece (sem diferenciar maiúsculas de minúsculas) na
+// numeração, nome, título, responsável, tags ou conteúdo da spec
+func (s SpecInfo) matchesText(text string) bool {
+	text = strings.ToLower(text)
+	fields := append([]string{s.Number, s.Name, s.Title, s.Owner}, s.Tags...)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), text) {
+			return true
+		}
+	}
+	return strings.Contains(s.text, text)
 }
 
 // ListResult contém resultado da listagem
 type ListResult struct {
-	Specs      []SpecInfo
-	Total      int
-	Complete   int
-	Incomplete int
-	WithErrors int
+	Specs      []SpecInfo `json:"specs"`
+	Total      int        `json:"total"`
+	Complete   int        `json:"complete"`
+	Incomplete int        `json:"incomplete"`
+	WithErrors int        `json:"with_errors"`
+	Groups     []Group    `json:"groups,omitempty"` // Preenchido apenas com ListOptions.GroupBy
 }
 
 // List lista todas as specs com status
@@ -66,6 +129,24 @@ func (s *Service) List(opts ListOptions) (*ListResult, error) {
 		path = filepath.Join(wd, "specs")
 	}
 
+	// Validar critério de ordenação e expressão de consulta antes de ler as specs
+	if opts.Sort != "" && !isSortCriterion(opts.Sort) {
+		return nil, fmt.Errorf("critério de ordenação inválido: %s (use %s)", opts.Sort, strings.Join(SortCriteria, ", "))
+	}
+	if opts.GroupBy != "" {
+		if err := ValidateGroupBy(opts.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+	var query *Query
+	if opts.Query != "" {
+		q, err := ParseQuery(opts.Query)
+		if err != nil {
+			return nil, err
+		}
+		query = q
+	}
+
 	// Verificar se caminho existe
 	if !s.fs.Exists(path) {
 		return nil, fmt.Errorf("caminho não existe: %s", path)
@@ -93,7 +174,13 @@ func (s *Service) List(opts ListOptions) (*ListResult, error) {
 	}
 
 	for _, file := range specFiles {
-		specInfo := s.getSpecInfo(file)
+		specInfo := s.getSpecInfo(path, file, opts.Numbering)
+		if !matchesFilters(specInfo, opts) {
+			continue
+		}
+		if query != nil && !query.Match(specInfo) {
+			continue
+		}
 		result.Specs = append(result.Specs, specInfo)
 		result.Total++
 
@@ -106,40 +193,28 @@ func (s *Service) List(opts ListOptions) (*ListResult, error) {
 		}
 	}
 
-	// Ordenar por numeração
+	// Ordenar por numeração (numérica e por nível, conforme o esquema) e, em seguida,
+	// pelo critério escolhido; a ordenação estável mantém empates em ordem de numeração
 	sort.Slice(result.Specs, func(i, j int) bool {
-		return result.Specs[i].Number < result.Specs[j].Number
+		return opts.Numbering.CompareFiles(result.Specs[i].Path, result.Specs[j].Path) < 0
 	})
-
-	// Aplicar filtros
-	if opts.Complete || opts.Incomplete || opts.Errors {
-		filtered := make([]SpecInfo, 0)
-		for _, spec := range result.Specs {
-			if opts.Complete && spec.Complete {
-				filtered = append(filtered, spec)
-			} else if opts.Incomplete && !spec.Complete && !spec.HasErrors {
-				filtered = append(filtered, spec)
-			} else if opts.Errors && spec.HasErrors {
-				filtered = append(filtered, spec)
-			}
-		}
-		result.Specs = filtered
-		// Recalcular contadores para specs filtradas
-		result.Total = len(filtered)
-		result.Complete = 0
-		result.Incomplete = 0
-		result.WithErrors = 0
-		for _, spec := range filtered {
-			if spec.HasErrors {
-				result.WithErrors++
-			} else if spec.Complete {
-				result.Complete++
-			} else {
-				result.Incomplete++
+	if opts.Sort != "" && opts.Sort != SortNumber {
+		sort.SliceStable(result.Specs, func(i, j int) bool {
+			if opts.Reverse {
+				return compareSpecs(result.Specs[j], result.Specs[i], opts.Sort) < 0
 			}
+			return compareSpecs(result.Specs[i], result.Specs[j], opts.Sort) < 0
+		})
+	} else if opts.Reverse {
+		for i, j := 0, len(result.Specs)-1; i < j; i, j = i+1, j-1 {
+			result.Specs[i], result.Specs[j] = result.Specs[j], result.Specs[i]
 		}
 	}
 
+	if opts.GroupBy != "" {
+		result.Groups = groupSpecs(result.Specs, opts.GroupBy)
+	}
+
 	return result, nil
 }
 
@@ -158,17 +233,105 @@ func (s *Service) findSpecFiles(root string) ([]string, error) {
 	return files, err
 }
 
+// isSortCriterion indica se o critério de ordenação é conhecido
+func isSortCriterion(criterion string) bool {
+	for _, c := range SortCriteria {
+		if c == criterion {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesFilters aplica os filtros de status de validação, diretório, tag, responsável,
+// ciclo de vida e texto. Filtros de status de validação combinam entre si com "ou".
+func matchesFilters(spec SpecInfo, opts ListOptions) bool {
+	if opts.Complete || opts.Incomplete || opts.Errors {
+		if !(opts.Complete && spec.Complete) &&
+			!(opts.Incomplete && !spec.Complete && !spec.HasErrors) &&
+			!(opts.Errors && spec.HasErrors) {
+			return false
+		}
+	}
+	if opts.Dir != "" {
+		dir := filepath.ToSlash(filepath.Clean(opts.Dir))
+		if dir != "." && spec.Dir != dir && !strings.HasPrefix(spec.Dir, dir+"/") {
+			return false
+		}
+	}
+	if opts.Tag != "" {
+		found := false
+		for _, tag := range spec.Tags {
+			if strings.EqualFold(tag, opts.Tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if opts.Owner != "" && !strings.EqualFold(spec.Owner, opts.Owner) {
+		return false
+	}
+	if opts.Status != "" && !strings.EqualFold(spec.Lifecycle, opts.Status) {
+		return false
+	}
+	if opts.Text != "" && !spec.matchesText(opts.Text) {
+		return false
+	}
+	return true
+}
+
+// lifecycleOrder define a ordem de ciclo de vida usada em SortStatus; situações não
+// padronizadas vêm depois, em ordem alfabética, e specs sem status ficam por último
+var lifecycleOrder = map[string]int{
+	metadata.StatusDraft:      0,
+	metadata.StatusActive:     1,
+	metadata.StatusDeprecated: 2,
+}
+
+// compareSpecs compara duas specs pelo critério informado (negativo se a vem antes de b)
+func compareSpecs(a, b SpecInfo, criterion string) int {
+	switch criterion {
+	case SortName:
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case SortModTime:
+		return a.ModTime.Compare(b.ModTime)
+	case SortProgress:
+		return a.Progress - b.Progress
+	case SortStatus:
+		rank := func(status string) int {
+			if r, ok := lifecycleOrder[status]; ok {
+				return r
+			}
+			if status == "" {
+				return len(lifecycleOrder) + 1
+			}
+			return len(lifecycleOrder)
+		}
+		if ra, rb := rank(a.Lifecycle), rank(b.Lifecycle); ra != rb {
+			return ra - rb
+		}
+		return strings.Compare(a.Lifecycle, b.Lifecycle)
+	}
+	return 0
+}
+
 // getSpecInfo obtém informações sobre uma spec
-func (s *Service) getSpecInfo(filePath string) SpecInfo {
+func (s *Service) getSpecInfo(root, filePath string, scheme numbering.Scheme) SpecInfo {
 	// Extrair numeração e nome do arquivo
 	fileName := filepath.Base(filePath)
 	nameWithoutExt := strings.TrimSuffix(fileName, ".spec.md")
 	
-	// Extrair numeração (ex.: "02-init" -> "02")
+	// Extrair numeração (ex.: "02-init" -> "02", "ADR-0007-usar-go" -> "ADR-0007")
 	parts := strings.SplitN(nameWithoutExt, "-", 2)
 	number := ""
 	name := nameWithoutExt
-	if len(parts) >= 2 {
+	if n, specName, ok := scheme.Split(fileName); ok {
+		number = scheme.Format(n)
+		name = specName
+	} else if len(parts) >= 2 {
 		number = parts[0]
 		name = strings.Join(parts[1:], "-")
 	} else {
@@ -226,5 +389,41 @@ func (s *Service) getSpecInfo(filePath string) SpecInfo {
 		}
 	}
 
+	if total := specInfo.Checklist.ItemCount; total > 0 {
+		specInfo.Progress = specInfo.Checklist.MarkedCount * 100 / total
+	}
+	if rel, err := filepath.Rel(root, filepath.Dir(filePath)); err == nil && rel != "." {
+		specInfo.Dir = filepath.ToSlash(rel)
+	}
+	if stat, err := s.fs.Stat(filePath); err == nil {
+		specInfo.ModTime = stat.ModTime()
+	}
+
+	// Metadados do frontmatter e conteúdo para busca textual
+	specInfo.Tags = []string{}
+	if content, err := s.fs.ReadFile(filePath); err == nil {
+		meta := metadata.Parse(string(content))
+		specInfo.Lifecycle = meta.Status
+		specInfo.Owner = meta.Owner
+		if len(meta.Tags) > 0 {
+			specInfo.Tags = meta.Tags
+		}
+		specInfo.Title = meta.Title
+		if specInfo.Title == "" {
+			specInfo.Title = headingTitle(metadata.Body(string(content)))
+		}
+		specInfo.text = strings.ToLower(string(content))
+	}
+
 	return specInfo
 }
+
+// headingTitle retorna o texto do primeiro cabeçalho de nível 1 ("# Título")
+func headingTitle(body string) string {
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "# "))
+		}
+	}
+	return ""
+}
@@ -1,10 +1,15 @@
 package lister
 
 import (
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/numbering"
 )
 
 func TestService_List_Success(t *testing.T) {
@@ -398,3 +403,246 @@ func TestService_List_NotDirectory(t *testing.T) {
 		t.Error("deveria retornar erro para caminho que não é diretório")
 	}
 }
+
+func TestService_List_SortsByNumberingScheme(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := t.TempDir()
+	for _, name := range []string{"template.spec.md", "100-c.spec.md", "10-b.spec.md", "03.1-sub.spec.md", "03-a.spec.md", "ADR-0001-x.spec.md"} {
+		if err := fs.WriteFile(filepath.Join(specsDir, name), []byte("# Spec\n"), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+
+	scheme, _ := numbering.ParseScheme("00.0")
+	result, err := service.List(ListOptions{Path: specsDir, Numbering: scheme})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	var numbers []string
+	for _, spec := range result.Specs {
+		numbers = append(numbers, spec.Number+":"+spec.Name)
+	}
+	// Ordem numérica e por nível; nomes fora do esquema vão para o final
+	expected := "03:a 03.1:sub 10:b 100:c ADR:0001-x :template"
+	if strings.Join(numbers, " ") != expected {
+		t.Errorf("ordem inesperada:\n  obtido:   %s\n  esperado: %s", strings.Join(numbers, " "), expected)
+	}
+}
+
+// writeListSpec cria uma spec mínima com frontmatter e quantidade de itens do checklist marcados
+func writeListSpec(t *testing.T, fs adapters.FileSystem, path, frontmatter string, marked int) {
+	t.Helper()
+	var b strings.Builder
+	if frontmatter != "" {
+		b.WriteString("---\n" + frontmatter + "\n---\n")
+	}
+	b.WriteString("# " + strings.TrimSuffix(filepath.Base(path), ".spec.md") + "\n\n")
+	for _, section := range []string{"1. Contexto e Objetivo", "2. Requisitos Funcionais", "3. Contratos e Interfaces", "4. Fluxos e Estados", "5. Dados", "6. NFRs (Não Funcionais)", "7. Guardrails", "8. Critérios de Aceite", "9. Testes", "10. Migração / Rollback", "11. Observações Operacionais", "12. Abertos / Fora de Escopo"} {
+		b.WriteString("## " + section + "\nConteúdo da seção.\n\n")
+	}
+	b.WriteString("## Checklist Rápido (preencha antes de gerar código)\n")
+	for i := 0; i < 6; i++ {
+		mark := " "
+		if i < marked {
+			mark = "x"
+		}
+		b.WriteString("- [" + mark + "] Item " + string(rune('A'+i)) + "?\n")
+	}
+	if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	if err := fs.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		t.Fatalf("falha ao criar %s: %v", path, err)
+	}
+}
+
+// listNames executa a listagem e retorna os nomes das specs na ordem obtida
+func listNames(t *testing.T, service *Service, opts ListOptions) string {
+	t.Helper()
+	result, err := service.List(opts)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	var names []string
+	for _, spec := range result.Specs {
+		names = append(names, spec.Name)
+	}
+	if result.Total != len(result.Specs) {
+		t.Errorf("Total = %d, esperado %d", result.Total, len(result.Specs))
+	}
+	return strings.Join(names, " ")
+}
+
+func TestService_List_SortAndFilters(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := t.TempDir()
+	writeListSpec(t, fs, filepath.Join(specsDir, "01-zeta.spec.md"), "status: active\nowner: ana\ntags: [cli]", 6)
+	writeListSpec(t, fs, filepath.Join(specsDir, "02-alfa.spec.md"), "status: draft\nowner: bruno\ntags: [cli, api]", 2)
+	writeListSpec(t, fs, filepath.Join(specsDir, "api", "03-beta.spec.md"), "status: review\nowner: ana\ntags: [api]", 4)
+	writeListSpec(t, fs, filepath.Join(specsDir, "04-gama.spec.md"), "", 0)
+
+	// Datas de modificação distintas para ordenação por mtime
+	base := time.Now().Add(-time.Hour)
+	for i, name := range []string{"04-gama.spec.md", "api/03-beta.spec.md", "01-zeta.spec.md", "02-alfa.spec.md"} {
+		mtime := base.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(filepath.Join(specsDir, name), mtime, mtime); err != nil {
+			t.Fatalf("falha ao alterar data de %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		opts     ListOptions
+		expected string
+	}{
+		{"numeração (padrão)", ListOptions{}, "zeta alfa beta gama"},
+		{"numeração invertida", ListOptions{Reverse: true}, "gama beta alfa zeta"},
+		{"nome", ListOptions{Sort: SortName}, "alfa beta gama zeta"},
+		{"data de modificação", ListOptions{Sort: SortModTime}, "gama beta zeta alfa"},
+		{"progresso", ListOptions{Sort: SortProgress}, "gama alfa beta zeta"},
+		{"progresso invertido", ListOptions{Sort: SortProgress, Reverse: true}, "zeta beta alfa gama"},
+		{"ciclo de vida", ListOptions{Sort: SortStatus}, "alfa zeta beta gama"},
+		{"diretório", ListOptions{Dir: "api"}, "beta"},
+		{"tag", ListOptions{Tag: "CLI"}, "zeta alfa"},
+		{"responsável", ListOptions{Owner: "ana"}, "zeta beta"},
+		{"ciclo de vida filtrado", ListOptions{Status: "draft"}, "alfa"},
+		{"texto no nome", ListOptions{Text: "gam"}, "gama"},
+		{"texto no conteúdo", ListOptions{Text: "item c"}, "zeta alfa beta gama"},
+		{"filtros combinados", ListOptions{Tag: "api", Owner: "ana"}, "beta"},
+		{"consulta", ListOptions{Query: "status=review and owner=ana"}, "beta"},
+		{"consulta com or e not", ListOptions{Query: "tag=api or not (status=active or progress>=50)"}, "alfa beta gama"},
+		{"consulta por progresso", ListOptions{Query: "progress>=50", Sort: SortName}, "beta zeta"},
+		{"consulta por validação", ListOptions{Query: "validation=completa"}, "zeta"},
+		{"consulta com contém", ListOptions{Query: "name~eta and dir!=api"}, "zeta"},
+		{"consulta por status vazio", ListOptions{Query: `status=""`}, "gama"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Path = specsDir
+			if got := listNames(t, service, tt.opts); got != tt.expected {
+				t.Errorf("obtido %q, esperado %q", got, tt.expected)
+			}
+		})
+	}
+
+	// Metadados expostos em SpecInfo
+	result, err := service.List(ListOptions{Path: specsDir, Dir: "api"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	spec := result.Specs[0]
+	if spec.Dir != "api" || spec.Lifecycle != "review" || spec.Owner != "ana" || spec.Progress != 66 || spec.Title != "03-beta" {
+		t.Errorf("metadados inesperados: %+v", spec)
+	}
+}
+
+func TestService_List_InvalidSortAndQuery(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+	specsDir := t.TempDir()
+
+	if _, err := service.List(ListOptions{Path: specsDir, Sort: "tamanho"}); err == nil || !strings.Contains(err.Error(), "critério de ordenação inválido") {
+		t.Errorf("esperado erro de critério inválido, obtido %v", err)
+	}
+	if _, err := service.List(ListOptions{Path: specsDir, Query: "cor=azul"}); err == nil || !strings.Contains(err.Error(), "campo de consulta desconhecido") {
+		t.Errorf("esperado erro de campo desconhecido, obtido %v", err)
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"status",
+		"status=",
+		"status=review and",
+		"(status=review",
+		"status=review)",
+		"status==review",
+		`owner="ana`,
+		"=review",
+	} {
+		if _, err := ParseQuery(expr); err == nil {
+			t.Errorf("ParseQuery(%q) deveria retornar erro", expr)
+		}
+	}
+
+	// status é o ciclo de vida do frontmatter
+	if _, err := ParseQuery("status=review"); err != nil {
+		t.Errorf("ParseQuery(\"status=review\") erro inesperado: %v", err)
+	}
+}
+
+func TestService_List_GroupBy(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := t.TempDir()
+	writeListSpec(t, fs, filepath.Join(specsDir, "01-a.spec.md"), "owner: ana\ntags: [cli, api]", 6)
+	writeListSpec(t, fs, filepath.Join(specsDir, "02-b.spec.md"), "status: draft", 3)
+	writeListSpec(t, fs, filepath.Join(specsDir, "api", "03-c.spec.md"), "owner: ana\ntags: [api]", 0)
+
+	describe := func(groups []Group) string {
+		var parts []string
+		for _, group := range groups {
+			var names []string
+			for _, spec := range group.Specs {
+				names = append(names, spec.Name)
+			}
+			parts = append(parts, group.Key+"="+strings.Join(names, ",")+"/"+strconv.Itoa(group.Complete)+"/"+strconv.Itoa(group.Progress))
+		}
+		return strings.Join(parts, " ")
+	}
+
+	tests := []struct {
+		groupBy  string
+		expected string
+	}{
+		{GroupDir, "=a,b/1/75 api=c/0/0"},
+		{GroupTag, "api=a,c/1/50 cli=a/1/100 =b/0/50"},
+		{GroupOwner, "ana=a,c/1/50 =b/0/50"},
+		{GroupStatus, "draft=b/0/50 =a,c/1/50"},
+	}
+	for _, tt := range tests {
+		result, err := service.List(ListOptions{Path: specsDir, GroupBy: tt.groupBy})
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if got := describe(result.Groups); got != tt.expected {
+			t.Errorf("GroupBy %s: obtido %q, esperado %q", tt.groupBy, got, tt.expected)
+		}
+		if result.Total != 3 {
+			t.Errorf("GroupBy %s: Total = %d, esperado 3", tt.groupBy, result.Total)
+		}
+	}
+
+	// Grupos respeitam filtros e ordenação
+	result, err := service.List(ListOptions{Path: specsDir, GroupBy: GroupDir, Sort: SortName, Reverse: true, Owner: "ana"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if got := describe(result.Groups); got != "=a/1/100 api=c/0/0" {
+		t.Errorf("grupos filtrados: obtido %q", got)
+	}
+
+	if _, err := service.List(ListOptions{Path: specsDir, GroupBy: "cor"}); err == nil {
+		t.Error("critério de agrupamento inválido deveria retornar erro")
+	}
+}
+
+func TestValidateGroupBy(t *testing.T) {
+	// Critérios aceitos por --group-by em specs list e specs view
+	for _, by := range []string{"dir", "tag", "status", "owner"} {
+		if err := ValidateGroupBy(by); err != nil {
+			t.Errorf("ValidateGroupBy(%q) erro inesperado: %v", by, err)
+		}
+	}
+	if err := ValidateGroupBy("autor"); err == nil {
+		t.Error("ValidateGroupBy(\"autor\") deveria retornar erro")
+	}
+}
@@ -0,0 +1,150 @@
+package metadata
+
+import (
+	"strings"
+)
+
+// Situações de ciclo de vida de uma spec (chave status)
+const (
+	StatusDraft      = "draft"
+	StatusActive     = "active"
+	StatusDeprecated = "deprecated"
+)
+
+// Metadata contém os metadados declarados no frontmatter de uma spec
+type Metadata struct {
+	Title     string
+	Status    string
+	Owner     string
+	Tags      []string
+	DependsOn []string
+	Aliases   []string            // Nomes anteriores da spec (registrados por `specs mv --alias`)
+	Fields    map[string][]string // Todas as chaves do frontmatter (valores escalares viram lista de 1 item)
+	Lines     map[string]int      // Linha (1-based) de cada chave
+	EndLine   int                 // Linha do delimitador de fechamento (0 se não houver frontmatter)
+}
+
+// Deprecated indica se a spec está marcada como obsoleta
+func (m *Metadata) Deprecated() bool {
+	return strings.EqualFold(m.Status, StatusDeprecated)
+}
+
+// Parse extrai o frontmatter YAML do início da spec. Apenas um subconjunto do YAML é aceito:
+// valores escalares, listas inline e listas em bloco.
+//
+//	---
+//	status: deprecated
+//	owner: time-core
+//	tags: [cli, validação]
+//	depends_on:
+//	  - 03-specs-validate
+//	aliases: [03-validacao]
+//	---
+//
+// Sem frontmatter (ou sem delimitador de fechamento), retorna metadados vazios.
+func Parse(content string) *Metadata {
+	m := &Metadata{
+		Fields: make(map[string][]string),
+		Lines:  make(map[string]int),
+	}
+
+	lines := strings.Split(content, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return m
+	}
+
+	end := 0
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			end = i
+			break
+		}
+	}
+	if end == 0 {
+		return m
+	}
+	m.EndLine = end + 1
+
+	key := ""
+	for i := 1; i < end; i++ {
+		line := strings.TrimRight(lines[i], " \t\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		// Item de lista em bloco da última chave
+		if strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+			if key != "" {
+				if value := unquote(strings.TrimSpace(strings.TrimPrefix(trimmed, "-"))); value != "" {
+					m.Fields[key] = append(m.Fields[key], value)
+				}
+			}
+			continue
+		}
+
+		name, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(name))
+		m.Lines[key] = i + 1
+		m.Fields[key] = parseValue(strings.TrimSpace(value))
+	}
+
+	m.Title = m.first("title")
+	m.Status = strings.ToLower(m.first("status"))
+	m.Owner = m.first("owner")
+	m.Tags = m.Fields["tags"]
+	m.DependsOn = m.Fields["depends_on"]
+	m.Aliases = m.Fields["aliases"]
+
+	return m
+}
+
+// Body retorna o conteúdo da spec sem o frontmatter, com linhas em branco no lugar
+// do frontmatter para preservar a numeração de linhas
+func Body(content string) string {
+	m := Parse(content)
+	if m.EndLine == 0 {
+		return content
+	}
+	lines := strings.Split(content, "\n")
+	for i := 0; i < m.EndLine; i++ {
+		lines[i] = ""
+	}
+	return strings.Join(lines, "\n")
+}
+
+// first retorna o primeiro valor da chave
+func (m *Metadata) first(key string) string {
+	if values := m.Fields[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// parseValue interpreta um valor escalar ou uma lista inline ([a, b])
+func parseValue(value string) []string {
+	if value == "" {
+		return nil
+	}
+	if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+		var values []string
+		for _, item := range strings.Split(value[1:len(value)-1], ",") {
+			if item = unquote(strings.TrimSpace(item)); item != "" {
+				values = append(values, item)
+			}
+		}
+		return values
+	}
+	return []string{unquote(value)}
+}
+
+// unquote remove aspas simples ou duplas ao redor do valor
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
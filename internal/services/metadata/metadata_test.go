@@ -0,0 +1,56 @@
+package metadata
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	content := strings.Join([]string{
+		"---",
+		"title: \"Validação\"",
+		"status: Deprecated",
+		"owner: time-core",
+		"# comentário",
+		"tags: [cli, 'validação']",
+		"depends_on:",
+		"  - 01-architecture",
+		"  - 02",
+		"---",
+		"# 03 - Validate",
+	}, "\n")
+
+	m := Parse(content)
+
+	if m.Title != "Validação" || m.Owner != "time-core" {
+		t.Errorf("metadados escalares inesperados: %+v", m)
+	}
+	if m.Status != StatusDeprecated || !m.Deprecated() {
+		t.Errorf("status deveria ser deprecated, obtido %q", m.Status)
+	}
+	if strings.Join(m.Tags, ",") != "cli,validação" {
+		t.Errorf("tags inesperadas: %v", m.Tags)
+	}
+	if strings.Join(m.DependsOn, ",") != "01-architecture,02" {
+		t.Errorf("depends_on inesperado: %v", m.DependsOn)
+	}
+	if m.Lines["depends_on"] != 7 || m.EndLine != 10 {
+		t.Errorf("linhas inesperadas: depends_on=%d, fim=%d", m.Lines["depends_on"], m.EndLine)
+	}
+}
+
+func TestParse_WithoutFrontmatter(t *testing.T) {
+	for _, content := range []string{"# 01 - Spec\n---\nstatus: deprecated\n---\n", "---\nstatus: deprecated\n# sem fechamento\n"} {
+		m := Parse(content)
+		if m.EndLine != 0 || m.Status != "" {
+			t.Errorf("não deveria haver frontmatter em %q: %+v", content, m)
+		}
+	}
+}
+
+func TestBody(t *testing.T) {
+	body := Body("---\nstatus: active\n---\n# 01 - Spec\n")
+	if body != "\n\n\n# 01 - Spec\n" {
+		t.Errorf("corpo deveria preservar a numeração de linhas, obtido %q", body)
+	}
+}
@@ -0,0 +1,300 @@
+package numbering
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultTemplate é o modelo de numeração padrão: dois dígitos (01-nome.spec.md)
+const DefaultTemplate = "00"
+
+var (
+	templateRegex = regexp.MustCompile(`^([^\d.\s/\\]*?)(0+)(\.0)?$`)
+	numberRegex   = regexp.MustCompile(`^(\d+(?:\.\d+)*)(?:-(.*))?$`)
+)
+
+// Scheme define o formato da numeração de specs: {prefixo}{numero}-{nome}.spec.md.
+// O valor zero equivale ao esquema padrão (dois dígitos, sem prefixo, numeração única).
+type Scheme struct {
+	Prefix       string   // Prefixo antes do número (ex.: "ADR-")
+	Width        int      // Mínimo de dígitos do primeiro nível, com zeros à esquerda (0 = 2)
+	Hierarchical bool     // Aceita subníveis separados por ponto (ex.: 03.2)
+	Namespaces   []string // Diretórios com sequência própria, relativos ao diretório de specs (ex.: "api")
+}
+
+// Number é uma numeração de spec, um valor por nível (ex.: 03.2 -> [3 2])
+type Number []int
+
+// ParseScheme interpreta um modelo de numeração: prefixo opcional seguido de zeros indicando a
+// largura e, opcionalmente, ".0" para numeração hierárquica. Ex.: "00", "000", "ADR-0000", "00.0"
+func ParseScheme(template string) (Scheme, error) {
+	if template == "" {
+		template = DefaultTemplate
+	}
+	match := templateRegex.FindStringSubmatch(template)
+	if match == nil {
+		return Scheme{}, fmt.Errorf("modelo de numeração inválido: %s (ex.: 00, 000, ADR-0000, 00.0)", template)
+	}
+	return Scheme{Prefix: match[1], Width: len(match[2]), Hierarchical: match[3] != ""}, nil
+}
+
+// String retorna o modelo do esquema (inverso de ParseScheme, sem os namespaces)
+func (s Scheme) String() string {
+	template := s.Prefix + strings.Repeat("0", s.width())
+	if s.Hierarchical {
+		template += ".0"
+	}
+	return template
+}
+
+// Placeholder descreve a numeração em mensagens (ex.: "{numero}", "ADR-{numero}")
+func (s Scheme) Placeholder() string {
+	return s.Prefix + "{numero}"
+}
+
+func (s Scheme) width() int {
+	if s.Width <= 0 {
+		return 2
+	}
+	return s.Width
+}
+
+// Format formata a numeração: primeiro nível com zeros à esquerda, subníveis sem (ex.: ADR-0007, 03.2)
+func (s Scheme) Format(n Number) string {
+	if len(n) == 0 {
+		return ""
+	}
+	parts := make([]string, len(n))
+	parts[0] = fmt.Sprintf("%0*d", s.width(), n[0])
+	for i := 1; i < len(n); i++ {
+		parts[i] = strconv.Itoa(n[i])
+	}
+	return s.Prefix + strings.Join(parts, ".")
+}
+
+// FileName monta o nome do arquivo da spec a partir da numeração e do nome descritivo
+func (s Scheme) FileName(n Number, name string) string {
+	return s.Format(n) + "-" + name + ".spec.md"
+}
+
+// Split separa numeração e nome descritivo de um nome de arquivo ou slug (sem diretório).
+// ok é falso se o nome não segue o esquema: prefixo ausente, largura ou zeros à esquerda
+// diferentes do formato canônico, subníveis sem numeração hierárquica ou nome vazio.
+func (s Scheme) Split(fileName string) (n Number, name string, ok bool) {
+	slug := strings.TrimSuffix(fileName, ".spec.md")
+	if !strings.HasPrefix(slug, s.Prefix) {
+		return nil, "", false
+	}
+	match := numberRegex.FindStringSubmatch(slug[len(s.Prefix):])
+	if match == nil || match[2] == "" {
+		return nil, "", false
+	}
+	n = parseNumber(match[1])
+	if (len(n) > 1 && !s.Hierarchical) || s.Format(n) != s.Prefix+match[1] {
+		return nil, "", false
+	}
+	return n, match[2], true
+}
+
+// Number extrai a numeração de um nome de arquivo ou slug (nil se não segue o esquema)
+func (s Scheme) Number(fileName string) Number {
+	n, _, _ := s.Split(fileName)
+	return n
+}
+
+// Reference separa numeração e nome de um slug para resolver referências a specs (depends_on,
+// anotações): no formato do esquema ou, fora dele, pelo primeiro hífen
+func (s Scheme) Reference(slug string) (number string, name string, ok bool) {
+	if n, name, ok := s.Split(slug); ok {
+		return s.Format(n), name, true
+	}
+	return strings.Cut(slug, "-")
+}
+
+// Loose extrai a numeração de um nome fora do padrão (ex.: "7_Nova API", "adr-7 auth"), para
+// correção automática. Retorna o restante do nome após a numeração e separadores.
+func (s Scheme) Loose(slug string) (n Number, rest string) {
+	if len(slug) >= len(s.Prefix) && strings.EqualFold(slug[:len(s.Prefix)], s.Prefix) {
+		slug = slug[len(s.Prefix):]
+	}
+	end := 0
+	for end < len(slug) && slug[end] >= '0' && slug[end] <= '9' {
+		end++
+	}
+	for s.Hierarchical && end+1 < len(slug) && slug[end] == '.' && slug[end+1] >= '0' && slug[end+1] <= '9' {
+		end++
+		for end < len(slug) && slug[end] >= '0' && slug[end] <= '9' {
+			end++
+		}
+	}
+	if end == 0 {
+		return nil, strings.TrimLeft(slug, "-_. ")
+	}
+	return parseNumber(slug[:end]), strings.TrimLeft(slug[end:], "-_. ")
+}
+
+// CompareFiles ordena specs pelo nome do arquivo: numeradas no esquema primeiro, pela numeração
+// (02 < 10 < 100, 03 < 03.1 < 03.2), e as demais em ordem alfabética. Com namespaces
+// configurados, as specs são agrupadas por diretório para não intercalar sequências.
+func (s Scheme) CompareFiles(a string, b string) int {
+	if len(s.Namespaces) > 0 {
+		if c := strings.Compare(filepath.Dir(a), filepath.Dir(b)); c != 0 {
+			return c
+		}
+	}
+	na, nb := s.Number(filepath.Base(a)), s.Number(filepath.Base(b))
+	switch {
+	case na != nil && nb == nil:
+		return -1
+	case na == nil && nb != nil:
+		return 1
+	}
+	if c := na.Compare(nb); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// Namespace retorna o namespace de numeração de uma spec a partir do caminho relativo ao
+// diretório de specs: o diretório configurado mais específico que a contém ("" = raiz)
+func (s Scheme) Namespace(rel string) string {
+	dir := path.Dir(filepath.ToSlash(rel))
+	namespace := ""
+	for _, ns := range s.Namespaces {
+		ns = CleanNamespace(ns)
+		if ns == "" || len(ns) <= len(namespace) {
+			continue
+		}
+		if dir == ns || strings.HasPrefix(dir, ns+"/") {
+			namespace = ns
+		}
+	}
+	return namespace
+}
+
+// CleanNamespace normaliza o diretório de um namespace (ex.: "./api/" -> "api"; raiz -> "")
+func CleanNamespace(namespace string) string {
+	namespace = path.Clean(filepath.ToSlash(strings.TrimSpace(namespace)))
+	if namespace == "." || namespace == "/" {
+		return ""
+	}
+	return namespace
+}
+
+// Qualify prefixa a numeração com o namespace (ex.: api/01); na raiz, retorna a numeração
+func Qualify(namespace string, number string) string {
+	if namespace == "" {
+		return number
+	}
+	return namespace + "/" + number
+}
+
+// parseNumber converte "03.2" em [3 2]
+func parseNumber(text string) Number {
+	fields := strings.Split(text, ".")
+	n := make(Number, len(fields))
+	for i, field := range fields {
+		n[i], _ = strconv.Atoi(field)
+	}
+	return n
+}
+
+// Compare compara numerações nível a nível; um prefixo vem antes (03 < 03.1 < 04)
+func (n Number) Compare(other Number) int {
+	for i := 0; i < len(n) && i < len(other); i++ {
+		if n[i] != other[i] {
+			if n[i] < other[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(n) - len(other)
+}
+
+// Equal indica se as numerações são iguais
+func (n Number) Equal(other Number) bool {
+	return n.Compare(other) == 0
+}
+
+// Parent retorna a numeração do nível anterior (nil no primeiro nível)
+func (n Number) Parent() Number {
+	if len(n) <= 1 {
+		return nil
+	}
+	return n[:len(n)-1]
+}
+
+// Child retorna a numeração de um subnível
+func (n Number) Child(value int) Number {
+	child := make(Number, len(n)+1)
+	copy(child, n)
+	child[len(n)] = value
+	return child
+}
+
+// Gaps retorna as numerações ausentes, em ordem: no primeiro nível, entre a menor e a maior
+// numeração; em cada subnível, de 1 até o maior irmão (ex.: 03.1 e 03.3 -> falta 03.2)
+func Gaps(numbers []Number) []Number {
+	present := make(map[string]bool)
+	children := make(map[string][]int) // numeração do pai -> valores dos filhos
+	first, last := -1, -1
+	for _, n := range numbers {
+		for depth := 1; depth <= len(n); depth++ {
+			prefix := n[:depth]
+			key := prefix.key()
+			if present[key] {
+				continue
+			}
+			present[key] = true
+			if depth == 1 {
+				if first < 0 || n[0] < first {
+					first = n[0]
+				}
+				if n[0] > last {
+					last = n[0]
+				}
+				continue
+			}
+			parent := prefix.Parent().key()
+			children[parent] = append(children[parent], prefix[depth-1])
+		}
+	}
+
+	var gaps []Number
+	var walk func(parent Number, from int, to int)
+	walk = func(parent Number, from int, to int) {
+		for v := from; v <= to; v++ {
+			n := parent.Child(v)
+			if !present[n.key()] {
+				gaps = append(gaps, n)
+				continue
+			}
+			if values := children[n.key()]; len(values) > 0 {
+				max := 0
+				for _, value := range values {
+					if value > max {
+						max = value
+					}
+				}
+				walk(n, 1, max)
+			}
+		}
+	}
+	if first >= 0 {
+		walk(nil, first, last)
+	}
+	return gaps
+}
+
+func (n Number) key() string {
+	parts := make([]string, len(n))
+	for i, v := range n {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ".")
+}
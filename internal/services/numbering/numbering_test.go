@@ -0,0 +1,149 @@
+package numbering
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestParseScheme(t *testing.T) {
+	tests := map[string]Scheme{
+		"":         {Width: 2},
+		"00":       {Width: 2},
+		"000":      {Width: 3},
+		"ADR-0000": {Prefix: "ADR-", Width: 4},
+		"00.0":     {Width: 2, Hierarchical: true},
+	}
+	for template, expected := range tests {
+		scheme, err := ParseScheme(template)
+		if err != nil {
+			t.Fatalf("ParseScheme(%q): erro inesperado: %v", template, err)
+		}
+		if !reflect.DeepEqual(scheme, expected) {
+			t.Errorf("ParseScheme(%q) = %+v, esperado %+v", template, scheme, expected)
+		}
+	}
+
+	for _, template := range []string{"NN", "1", "00.00", "ADR-", "a/00"} {
+		if _, err := ParseScheme(template); err == nil {
+			t.Errorf("ParseScheme(%q): esperado erro", template)
+		}
+	}
+}
+
+func TestScheme_Split(t *testing.T) {
+	tests := []struct {
+		template string
+		fileName string
+		number   string
+		name     string
+	}{
+		{"00", "03-specs-validate.spec.md", "03", "specs-validate"},
+		{"00", "100-centesima.spec.md", "100", "centesima"},
+		{"00", "3-sem-zero.spec.md", "", ""},
+		{"00", "003-zeros-demais.spec.md", "", ""},
+		{"00", "03.2-sub.spec.md", "", ""},
+		{"00", "03-.spec.md", "", ""},
+		{"00", "template-default.spec.md", "", ""},
+		{"000", "007-auth.spec.md", "007", "auth"},
+		{"000", "07-auth.spec.md", "", ""},
+		{"ADR-0000", "ADR-0007-usar-go.spec.md", "ADR-0007", "usar-go"},
+		{"ADR-0000", "0007-usar-go.spec.md", "", ""},
+		{"00.0", "03.2-sub.spec.md", "03.2", "sub"},
+		{"00.0", "03.2.1-subsub", "03.2.1", "subsub"},
+		{"00.0", "03.02-sub.spec.md", "", ""},
+	}
+	for _, tt := range tests {
+		scheme, _ := ParseScheme(tt.template)
+		n, name, ok := scheme.Split(tt.fileName)
+		if ok != (tt.number != "") || (ok && (scheme.Format(n) != tt.number || name != tt.name)) {
+			t.Errorf("[%s] Split(%q) = %v %q %v, esperado %q %q", tt.template, tt.fileName, n, name, ok, tt.number, tt.name)
+		}
+	}
+}
+
+func TestScheme_Loose(t *testing.T) {
+	scheme, _ := ParseScheme("ADR-0000")
+	if n, rest := scheme.Loose("adr-7 Usar Go"); !n.Equal(Number{7}) || rest != "Usar Go" {
+		t.Errorf("Loose inesperado: %v %q", n, rest)
+	}
+	if n, rest := scheme.Loose("Sem numero"); n != nil || rest != "Sem numero" {
+		t.Errorf("Loose inesperado: %v %q", n, rest)
+	}
+
+	scheme, _ = ParseScheme("00.0")
+	if n, rest := scheme.Loose("3.2_sub"); !n.Equal(Number{3, 2}) || rest != "sub" {
+		t.Errorf("Loose inesperado: %v %q", n, rest)
+	}
+}
+
+func TestNumber_Compare(t *testing.T) {
+	ordered := []Number{{2}, {3}, {3, 1}, {3, 2}, {3, 10}, {4}, {10}}
+	for i := 1; i < len(ordered); i++ {
+		if ordered[i-1].Compare(ordered[i]) >= 0 || ordered[i].Compare(ordered[i-1]) <= 0 {
+			t.Errorf("esperado %v < %v", ordered[i-1], ordered[i])
+		}
+	}
+}
+
+func TestGaps(t *testing.T) {
+	scheme := Scheme{Hierarchical: true}
+	gaps := Gaps([]Number{{1}, {3}, {3, 1}, {3, 3}, {3, 3, 2}, {5, 2}})
+
+	var formatted []string
+	for _, n := range gaps {
+		formatted = append(formatted, scheme.Format(n))
+	}
+	if strings.Join(formatted, " ") != "02 03.2 03.3.1 04 05.1" {
+		t.Errorf("gaps inesperados: %v", formatted)
+	}
+
+	if gaps := Gaps(nil); len(gaps) != 0 {
+		t.Errorf("esperado nenhum gap, obtido %v", gaps)
+	}
+}
+
+func TestScheme_CompareFiles(t *testing.T) {
+	files := []string{"specs/template.spec.md", "specs/100-c.spec.md", "specs/api/03.2-b.spec.md", "specs/10-b.spec.md", "specs/03-a.spec.md", "specs/03.1-a.spec.md"}
+	scheme, _ := ParseScheme("00.0")
+	sort.Slice(files, func(i, j int) bool { return scheme.CompareFiles(files[i], files[j]) < 0 })
+
+	expected := "specs/03-a.spec.md specs/03.1-a.spec.md specs/api/03.2-b.spec.md specs/10-b.spec.md specs/100-c.spec.md specs/template.spec.md"
+	if strings.Join(files, " ") != expected {
+		t.Errorf("ordem inesperada: %v", files)
+	}
+
+	// Com namespaces, sequências de diretórios diferentes não são intercaladas
+	scheme.Namespaces = []string{"api"}
+	sort.Slice(files, func(i, j int) bool { return scheme.CompareFiles(files[i], files[j]) < 0 })
+	expected = "specs/03-a.spec.md specs/03.1-a.spec.md specs/10-b.spec.md specs/100-c.spec.md specs/template.spec.md specs/api/03.2-b.spec.md"
+	if strings.Join(files, " ") != expected {
+		t.Errorf("ordem inesperada com namespaces: %v", files)
+	}
+}
+
+func TestScheme_Namespace(t *testing.T) {
+	scheme := Scheme{Namespaces: []string{"api", "./cli/", "api/v2"}}
+	tests := map[string]string{
+		"01-visao.spec.md":         "",
+		"api/01-auth.spec.md":      "api",
+		"api/extra/02-x.spec.md":   "api",
+		"api/v2/01-auth.spec.md":   "api/v2",
+		"cli/01-init.spec.md":      "cli",
+		"client/01-sdk.spec.md":    "",
+		"docs/api/01-guia.spec.md": "",
+	}
+	for rel, expected := range tests {
+		if namespace := scheme.Namespace(rel); namespace != expected {
+			t.Errorf("Namespace(%q) = %q, esperado %q", rel, namespace, expected)
+		}
+	}
+
+	if q := Qualify("api", "01"); q != "api/01" {
+		t.Errorf("Qualify inesperado: %s", q)
+	}
+	if q := Qualify("", "01"); q != "01" {
+		t.Errorf("Qualify inesperado: %s", q)
+	}
+}
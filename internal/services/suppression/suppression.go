@@ -0,0 +1,154 @@
+package suppression
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regras que podem ser suprimidas por diretivas inline
+const (
+	RuleStructure      = "structure"
+	RuleMissingSection = "missing-section"
+	RuleChecklist      = "checklist"
+	RulePlaceholder    = "placeholder"
+	RuleEmptySection   = "empty-section"
+	RuleBoilerplate    = "boilerplate-section"
+	RuleRequirements   = "requirements"
+	RuleNumbering      = "numbering"
+	RuleLinks          = "links"
+	RuleFormat         = "format"
+	RuleOrphans        = "orphans"
+	RuleDependencies   = "dependencies"
+	RuleExternalLinks  = "external-links"
+	RuleTitles         = "titles"
+)
+
+// ValidatorRules são as regras avaliadas por `specs validate`
+var ValidatorRules = []string{RuleStructure, RuleMissingSection, RuleChecklist, RulePlaceholder, RuleEmptySection, RuleBoilerplate, RuleRequirements}
+
+// CheckerRules são as regras avaliadas por `specs check`
+var CheckerRules = []string{RuleNumbering, RuleLinks, RuleFormat, RuleOrphans, RuleDependencies, RuleExternalLinks, RuleTitles}
+
+// Directive representa uma diretiva de supressão encontrada em uma spec
+type Directive struct {
+	Line     int    // Linha da diretiva (1-based)
+	Rule     string // Ex.: "links", "missing-section:Migração"
+	NextLine bool   // true para specs-disable-next-line (aplica-se apenas à linha seguinte)
+	Used     bool
+}
+
+// Set contém as diretivas de uma spec
+type Set struct {
+	Directives []*Directive
+}
+
+var (
+	directiveRegex  = regexp.MustCompile(`<!--\s*specs-disable(-next-line)?\s*:?\s*(.*?)\s*-->`)
+	inlineCodeRegex = regexp.MustCompile("`[^`]*`")
+)
+
+// Parse extrai diretivas de supressão do conteúdo de uma spec.
+// Diretivas dentro de blocos de código ou código inline são ignoradas.
+//
+// Formatos aceitos:
+//
+//	<!-- specs-disable: missing-section:Migração, links -->  (arquivo inteiro)
+//	<!-- specs-disable-next-line links -->                   (apenas a próxima linha)
+func Parse(content string) *Set {
+	set := &Set{}
+	inFence := false
+
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !strings.Contains(line, "specs-disable") {
+			continue
+		}
+
+		line = inlineCodeRegex.ReplaceAllString(line, "")
+		for _, match := range directiveRegex.FindAllStringSubmatch(line, -1) {
+			nextLine := match[1] != ""
+			for _, rule := range strings.FieldsFunc(match[2], func(r rune) bool { return r == ',' || r == ' ' }) {
+				set.Directives = append(set.Directives, &Directive{
+					Line:     i + 1,
+					Rule:     rule,
+					NextLine: nextLine,
+				})
+			}
+		}
+	}
+
+	return set
+}
+
+// Suppresses indica se um problema da regra (na linha informada; 0 = sem linha)
+// está suprimido, marcando a diretiva como utilizada
+func (s *Set) Suppresses(rule string, line int) bool {
+	if s == nil {
+		return false
+	}
+	suppressed := false
+	for _, d := range s.Directives {
+		if !Matches(d.Rule, rule) {
+			continue
+		}
+		if d.NextLine && (line == 0 || line != d.Line+1) {
+			continue
+		}
+		d.Used = true
+		suppressed = true
+	}
+	return suppressed
+}
+
+// Unused retorna diretivas não utilizadas cujas regras pertencem ao conjunto informado
+func (s *Set) Unused(rules []string) []*Directive {
+	if s == nil {
+		return nil
+	}
+	var unused []*Directive
+	for _, d := range s.Directives {
+		if !d.Used && belongsTo(d.Rule, rules) {
+			unused = append(unused, d)
+		}
+	}
+	return unused
+}
+
+// Unknown retorna diretivas com regras desconhecidas
+func (s *Set) Unknown() []*Directive {
+	if s == nil {
+		return nil
+	}
+	var unknown []*Directive
+	for _, d := range s.Directives {
+		if !belongsTo(d.Rule, ValidatorRules) && !belongsTo(d.Rule, CheckerRules) {
+			unknown = append(unknown, d)
+		}
+	}
+	return unknown
+}
+
+// Matches indica se a regra de uma diretiva cobre a regra de um problema.
+// "missing-section" cobre "missing-section:Migração"; comparação ignora maiúsculas.
+func Matches(directiveRule, problemRule string) bool {
+	if strings.EqualFold(directiveRule, problemRule) {
+		return true
+	}
+	base, _, _ := strings.Cut(problemRule, ":")
+	return !strings.Contains(directiveRule, ":") && strings.EqualFold(directiveRule, base)
+}
+
+// belongsTo indica se a regra (ou sua base antes de ":") está no conjunto
+func belongsTo(rule string, rules []string) bool {
+	base, _, _ := strings.Cut(rule, ":")
+	for _, r := range rules {
+		if strings.EqualFold(base, r) {
+			return true
+		}
+	}
+	return false
+}
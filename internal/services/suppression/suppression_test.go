@@ -0,0 +1,65 @@
+package suppression
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	content := "# Spec\n" +
+		"<!-- specs-disable: missing-section:Migração, links -->\n" +
+		"<!-- specs-disable-next-line links -->\n" +
+		"[x](09-missing.spec.md)\n" +
+		"Exemplo em código: `<!-- specs-disable: checklist -->`\n" +
+		"```\n" +
+		"<!-- specs-disable: structure -->\n" +
+		"```\n"
+
+	set := Parse(content)
+	if len(set.Directives) != 3 {
+		t.Fatalf("esperado 3 diretivas, obtido %d", len(set.Directives))
+	}
+
+	expected := []Directive{
+		{Line: 2, Rule: "missing-section:Migração"},
+		{Line: 2, Rule: "links"},
+		{Line: 3, Rule: "links", NextLine: true},
+	}
+	for i, d := range set.Directives {
+		if d.Line != expected[i].Line || d.Rule != expected[i].Rule || d.NextLine != expected[i].NextLine {
+			t.Errorf("diretiva %d: esperado %+v, obtido %+v", i, expected[i], *d)
+		}
+	}
+}
+
+func TestSet_Suppresses(t *testing.T) {
+	set := Parse("<!-- specs-disable-next-line links -->\n[x](a.spec.md)\n<!-- specs-disable: missing-section -->\n")
+
+	if set.Suppresses(RuleLinks, 5) {
+		t.Error("diretiva next-line não deveria suprimir outra linha")
+	}
+	if !set.Suppresses(RuleLinks, 2) {
+		t.Error("diretiva next-line deveria suprimir a linha seguinte")
+	}
+	if !set.Suppresses("missing-section:Dados", 0) {
+		t.Error("missing-section deveria cobrir qualquer seção")
+	}
+	if len(set.Unused(CheckerRules)) != 0 || len(set.Unused(ValidatorRules)) != 0 {
+		t.Error("todas as diretivas foram utilizadas")
+	}
+}
+
+func TestSet_UnusedAndUnknown(t *testing.T) {
+	set := Parse("<!-- specs-disable: missing-section:Migração, links, inexistente -->\n")
+	set.Suppresses("missing-section:Dados", 0)
+
+	unused := set.Unused(ValidatorRules)
+	if len(unused) != 1 || unused[0].Rule != "missing-section:Migração" {
+		t.Errorf("esperado missing-section:Migração não utilizada, obtido %v", unused)
+	}
+	unused = set.Unused(CheckerRules)
+	if len(unused) != 1 || unused[0].Rule != "links" {
+		t.Errorf("esperado links não utilizada, obtido %v", unused)
+	}
+	unknown := set.Unknown()
+	if len(unknown) != 1 || unknown[0].Rule != "inexistente" {
+		t.Errorf("esperado regra desconhecida 'inexistente', obtido %v", unknown)
+	}
+}
@@ -0,0 +1,190 @@
+package trace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/validator"
+)
+
+// Seções cruzadas com os requisitos funcionais
+const (
+	SectionCriteria = "Critérios de Aceite"
+	SectionTests    = "Testes"
+)
+
+// Service gera a matriz de rastreabilidade entre requisitos, critérios de aceite e testes
+type Service struct {
+	fs        adapters.FileSystem
+	validator *validator.Service
+}
+
+// NewService cria uma nova instância do Service
+func NewService(fs adapters.FileSystem) *Service {
+	return &Service{
+		fs:        fs,
+		validator: validator.NewService(fs),
+	}
+}
+
+// TraceOptions contém opções para rastreabilidade
+type TraceOptions struct {
+	Path string // Caminho para arquivo ou diretório
+}
+
+// RequirementTrace contém a rastreabilidade de um requisito
+type RequirementTrace struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Line     int    `json:"line"`
+	Criteria []int  `json:"criteria"` // Linhas em "Critérios de Aceite" que mencionam o requisito
+	Tests    []int  `json:"tests"`    // Linhas em "Testes" que mencionam o requisito
+	Covered  bool   `json:"covered"`  // Possui critério de aceite e teste
+}
+
+// SpecTrace contém a rastreabilidade dos requisitos de uma spec
+type SpecTrace struct {
+	File         string             `json:"file"` // Relativo ao caminho verificado
+	Requirements []RequirementTrace `json:"requirements"`
+}
+
+// TraceResult contém a matriz de rastreabilidade
+type TraceResult struct {
+	Specs             []SpecTrace `json:"specs"`
+	TotalRequirements int         `json:"total_requirements"`
+	Covered           int         `json:"covered"`
+	Uncovered         int         `json:"uncovered"`
+}
+
+// Trace extrai os requisitos da seção 2 de cada spec e os cruza com as seções
+// 8 (Critérios de Aceite) e 9 (Testes). Specs sem requisitos não entram na matriz.
+func (s *Service) Trace(opts TraceOptions) (*TraceResult, error) {
+	path := opts.Path
+	if path == "" {
+		wd, err := s.fs.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("falha ao obter diretório atual: %w", err)
+		}
+		path = filepath.Join(wd, "specs")
+	}
+
+	if !s.fs.Exists(path) {
+		return nil, fmt.Errorf("caminho não existe: %s", path)
+	}
+
+	stat, err := s.fs.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter informações do caminho: %w", err)
+	}
+
+	basePath := path
+	var specFiles []string
+	if stat.IsDir() {
+		specFiles, err = s.findSpecFiles(path)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
+		}
+	} else {
+		if !strings.HasSuffix(path, ".spec.md") {
+			return nil, fmt.Errorf("arquivo deve ter extensão .spec.md: %s", path)
+		}
+		basePath = filepath.Dir(path)
+		specFiles = []string{path}
+	}
+	sort.Strings(specFiles)
+
+	result := &TraceResult{Specs: []SpecTrace{}}
+	for _, file := range specFiles {
+		data, err := s.fs.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler %s: %w", file, err)
+		}
+
+		spec := s.traceSpec(string(data))
+		if len(spec.Requirements) == 0 {
+			continue
+		}
+		spec.File, _ = filepath.Rel(basePath, file)
+
+		for _, req := range spec.Requirements {
+			result.TotalRequirements++
+			if req.Covered {
+				result.Covered++
+			} else {
+				result.Uncovered++
+			}
+		}
+		result.Specs = append(result.Specs, spec)
+	}
+
+	return result, nil
+}
+
+// traceSpec cruza os requisitos de uma spec com suas menções em critérios de aceite e testes
+func (s *Service) traceSpec(content string) SpecTrace {
+	criteria := make(map[string][]int)
+	tests := make(map[string][]int)
+	for _, ref := range s.validator.RequirementReferences(content) {
+		switch ref.Section {
+		case SectionCriteria:
+			criteria[ref.ID] = appendLine(criteria[ref.ID], ref.Line)
+		case SectionTests:
+			tests[ref.ID] = appendLine(tests[ref.ID], ref.Line)
+		}
+	}
+
+	spec := SpecTrace{}
+	seen := make(map[string]bool)
+	for _, req := range s.validator.Requirements(content) {
+		// IDs duplicados são reportados por `specs validate`
+		if seen[req.ID] {
+			continue
+		}
+		seen[req.ID] = true
+
+		trace := RequirementTrace{
+			ID:       req.ID,
+			Title:    req.Title,
+			Line:     req.Line,
+			Criteria: criteria[req.ID],
+			Tests:    tests[req.ID],
+		}
+		if trace.Criteria == nil {
+			trace.Criteria = []int{}
+		}
+		if trace.Tests == nil {
+			trace.Tests = []int{}
+		}
+		trace.Covered = len(trace.Criteria) > 0 && len(trace.Tests) > 0
+		spec.Requirements = append(spec.Requirements, trace)
+	}
+
+	return spec
+}
+
+// appendLine adiciona a linha se ainda não estiver presente (várias menções na mesma linha)
+func appendLine(lines []int, line int) []int {
+	if len(lines) > 0 && lines[len(lines)-1] == line {
+		return lines
+	}
+	return append(lines, line)
+}
+
+// findSpecFiles encontra todos os arquivos .spec.md recursivamente
+func (s *Service) findSpecFiles(root string) ([]string, error) {
+	var files []string
+	err := s.fs.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".spec.md") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
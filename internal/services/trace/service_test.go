@@ -0,0 +1,75 @@
+package trace
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+const tracedSpec = `# 01 - Spec
+
+## 1. Contexto e Objetivo
+Teste
+
+## 2. Requisitos Funcionais
+- **RF01 - Primeiro:**
+  - detalhe
+- **RF02 - Segundo:**
+- **RF03 - Terceiro:**
+
+## 8. Critérios de Aceite
+- [x] Atende RF01 e RF02
+- [x] Também RF01
+
+## 9. Testes
+- Unitários para RF01
+- ` + "`RF03`" + ` em código inline não conta
+`
+
+func TestService_Trace(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	if err := fs.WriteFile(filepath.Join(tmpDir, "01-spec.spec.md"), []byte(tracedSpec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+	if err := fs.WriteFile(filepath.Join(tmpDir, "00-contexto.spec.md"), []byte("# 00 - Contexto\n"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	result, err := service.Trace(TraceOptions{Path: tmpDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(result.Specs) != 1 || result.Specs[0].File != "01-spec.spec.md" {
+		t.Fatalf("esperada apenas a spec com requisitos, obtido %+v", result.Specs)
+	}
+	if result.TotalRequirements != 3 || result.Covered != 1 || result.Uncovered != 2 {
+		t.Errorf("totais inesperados: %d requisitos, %d cobertos, %d sem cobertura", result.TotalRequirements, result.Covered, result.Uncovered)
+	}
+
+	reqs := result.Specs[0].Requirements
+	if reqs[0].ID != "RF01" || reqs[0].Title != "Primeiro" || !reqs[0].Covered {
+		t.Errorf("RF01 deveria estar coberto: %+v", reqs[0])
+	}
+	if len(reqs[0].Criteria) != 2 || len(reqs[0].Tests) != 1 {
+		t.Errorf("RF01: esperado 2 critérios e 1 teste, obtido %v e %v", reqs[0].Criteria, reqs[0].Tests)
+	}
+	if reqs[1].Covered || len(reqs[1].Criteria) != 1 || len(reqs[1].Tests) != 0 {
+		t.Errorf("RF02 deveria ter critério sem teste: %+v", reqs[1])
+	}
+	if reqs[2].Covered || len(reqs[2].Tests) != 0 {
+		t.Errorf("RF03 não deveria estar coberto: %+v", reqs[2])
+	}
+}
+
+func TestService_Trace_InvalidPath(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+
+	if _, err := service.Trace(TraceOptions{Path: filepath.Join(t.TempDir(), "inexistente")}); err == nil {
+		t.Error("deveria retornar erro para caminho inexistente")
+	}
+}
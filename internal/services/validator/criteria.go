@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	criterionRegex   = regexp.MustCompile(`^-\s+\[([ xX])\]\s+(.+)$`)
+	criterionIDRegex = regexp.MustCompile(`^\*\*(CA\d{2,})[\s:.–-]*\*\*[\s:.–-]*`)
+)
+
+// Criterion representa um critério de aceite (item da seção "Critérios de Aceite").
+// O ID é explícito quando o texto começa com ele em negrito (`- [x] **CA03** Texto`);
+// sem ID explícito é ordinal: o primeiro item é CA01, o segundo CA02 e assim por diante.
+// IDs ordinais mudam quando um critério é inserido, então specs referenciadas por testes
+// devem usar IDs explícitos.
+type Criterion struct {
+	ID       string
+	Text     string
+	Line     int
+	Checked  bool
+	Explicit bool // ID escrito no texto do critério
+}
+
+// AcceptanceCriteria extrai os itens de checklist da seção "Critérios de Aceite", na ordem em que aparecem
+func (s *Service) AcceptanceCriteria(content string) []Criterion {
+	var criteria []Criterion
+	for _, section := range s.parseSections(content) {
+		if section.Name != "Critérios de Aceite" {
+			continue
+		}
+		for _, line := range section.Lines {
+			if line.InCode {
+				continue
+			}
+			matches := criterionRegex.FindStringSubmatch(strings.TrimSpace(line.Text))
+			if matches == nil {
+				continue
+			}
+			criterion := Criterion{
+				ID:      fmt.Sprintf("CA%02d", len(criteria)+1),
+				Text:    strings.TrimSpace(matches[2]),
+				Line:    line.Number,
+				Checked: matches[1] != " ",
+			}
+			if id := criterionIDRegex.FindStringSubmatch(criterion.Text); id != nil {
+				criterion.ID, criterion.Explicit = id[1], true
+				criterion.Text = strings.TrimSpace(criterion.Text[len(id[0]):])
+			}
+			criteria = append(criteria, criterion)
+		}
+	}
+	return criteria
+}
+
+// checkCriteria verifica os IDs explícitos de critérios de aceite: únicos e, quando a spec
+// usa IDs explícitos, presentes em todos os critérios (misturar com IDs ordinais faria um
+// critério sem ID assumir o ID de outro)
+func (s *Service) checkCriteria(content string) []requirementIssue {
+	var issues []requirementIssue
+
+	criteria := s.AcceptanceCriteria(content)
+	explicit := false
+	for _, c := range criteria {
+		explicit = explicit || c.Explicit
+	}
+	if !explicit {
+		return nil
+	}
+
+	defined := make(map[string]bool, len(criteria))
+	for _, c := range criteria {
+		switch {
+		case !c.Explicit:
+			issues = append(issues, requirementIssue{c.Line, "critério de aceite sem ID (esperado **CANN** no início do texto, como nos demais critérios)"})
+		case defined[c.ID]:
+			issues = append(issues, requirementIssue{c.Line, fmt.Sprintf("critério de aceite %s duplicado", c.ID)})
+		default:
+			defined[c.ID] = true
+		}
+	}
+	return issues
+}
@@ -0,0 +1,57 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+func TestService_AcceptanceCriteria(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+
+	content := "# 01 - Teste\n\n## 8. Critérios de Aceite\n\n- [x] Primeiro\n- [ ] Segundo\n```\n- [ ] Em código\n```\n\n## 9. Testes\n\n- [ ] Fora da seção\n"
+	criteria := service.AcceptanceCriteria(content)
+
+	if len(criteria) != 2 {
+		t.Fatalf("esperado 2 critérios, obtido %v", criteria)
+	}
+	if criteria[0].ID != "CA01" || !criteria[0].Checked || criteria[0].Line != 5 {
+		t.Errorf("primeiro critério inesperado: %+v", criteria[0])
+	}
+	if criteria[1].ID != "CA02" || criteria[1].Checked || criteria[1].Text != "Segundo" {
+		t.Errorf("segundo critério inesperado: %+v", criteria[1])
+	}
+}
+
+func TestService_AcceptanceCriteria_ExplicitIDs(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+
+	content := "# 01 - Teste\n\n## 8. Critérios de Aceite\n\n- [x] **CA02** Segundo\n- [ ] **CA01:** Primeiro\n- [ ] Sem ID\n- [ ] **CA02** - Repetido\n"
+	criteria := service.AcceptanceCriteria(content)
+
+	var got []string
+	for _, c := range criteria {
+		got = append(got, c.ID+"="+c.Text)
+	}
+	if expected := "CA02=Segundo CA01=Primeiro CA03=Sem ID CA02=Repetido"; strings.Join(got, " ") != expected {
+		t.Errorf("critérios inesperados:\n  obtido:   %s\n  esperado: %s", strings.Join(got, " "), expected)
+	}
+	if !criteria[0].Explicit || criteria[2].Explicit {
+		t.Errorf("indicação de ID explícito inesperada: %+v", criteria)
+	}
+
+	var issues []string
+	for _, issue := range service.checkCriteria(content) {
+		issues = append(issues, issue.Message)
+	}
+	expected := "critério de aceite sem ID (esperado **CANN** no início do texto, como nos demais critérios); critério de aceite CA02 duplicado"
+	if strings.Join(issues, "; ") != expected {
+		t.Errorf("problemas inesperados: %v", issues)
+	}
+
+	// Specs sem IDs explícitos continuam com IDs ordinais, sem erros
+	if issues := service.checkCriteria("# 01 - Teste\n\n## 8. Critérios de Aceite\n\n- [x] Primeiro\n- [ ] Segundo\n"); len(issues) != 0 {
+		t.Errorf("IDs ordinais não deveriam gerar problemas: %v", issues)
+	}
+}
@@ -0,0 +1,167 @@
+package validator
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/dreibox/specs/internal/services/baseline"
+	"github.com/dreibox/specs/internal/services/suppression"
+	"github.com/dreibox/specs/internal/templates"
+)
+
+// TemplateFileName é o template a partir do qual novas specs são copiadas
+const TemplateFileName = "template-default.spec.md"
+
+var (
+	placeholderRegex = regexp.MustCompile(`\b(TODO|TBD|FIXME)\b`)
+	inlineCodeRegex  = regexp.MustCompile("`[^`]*`")
+	htmlCommentRegex = regexp.MustCompile(`<!--.*?-->`)
+)
+
+// specSection representa uma seção de nível 2 (##) de uma spec
+type specSection struct {
+	Name  string // Nome normalizado (ex.: "Migração"); vazio para o trecho antes da primeira seção
+	Line  int    // Linha do título (1-based)
+	Lines []sectionLine
+}
+
+// sectionLine é uma linha do corpo de uma seção
+type sectionLine struct {
+	Number int
+	Text   string
+	InCode bool // Dentro de bloco de código
+}
+
+// checkPlaceholders detecta conteúdo de template não preenchido: texto de placeholder
+// (TODO, TBD, FIXME), seções obrigatórias vazias e seções idênticas ao template
+// (seções resolvidas uma vez por validação, ver templateSections).
+// Retorna as mensagens que não foram suprimidas por diretivas inline.
+func (s *Service) checkPlaceholders(path string, content string, suppressions *suppression.Set, template map[string]string) []string {
+	// O próprio template é composto apenas de placeholders
+	if filepath.Base(path) == TemplateFileName {
+		return nil
+	}
+
+	required := make(map[string]bool, len(RequiredSections))
+	for _, section := range RequiredSections {
+		required[section] = true
+	}
+
+	var issues []string
+	for _, section := range s.parseSections(content) {
+		body := sectionBody(section.Lines)
+
+		if required[section.Name] {
+			if body == "" {
+				if !suppressions.Suppresses(suppression.RuleEmptySection+":"+section.Name, section.Line) {
+					issues = append(issues, fmt.Sprintf("linha %d: seção '%s' está vazia", section.Line, section.Name))
+				}
+				continue
+			}
+			if expected, ok := template[section.Name]; ok && body == expected {
+				if !suppressions.Suppresses(suppression.RuleBoilerplate+":"+section.Name, section.Line) {
+					issues = append(issues, fmt.Sprintf("linha %d: seção '%s' idêntica ao template", section.Line, section.Name))
+				}
+				continue
+			}
+		}
+
+		for _, line := range section.Lines {
+			if line.InCode {
+				continue
+			}
+			match := placeholderRegex.FindString(inlineCodeRegex.ReplaceAllString(line.Text, ""))
+			if match == "" || suppressions.Suppresses(suppression.RulePlaceholder, line.Number) {
+				continue
+			}
+			issues = append(issues, fmt.Sprintf("linha %d: texto de placeholder '%s'", line.Number, match))
+		}
+	}
+
+	return issues
+}
+
+// parseSections divide o conteúdo em seções de nível 2, ignorando títulos dentro de blocos de código
+func (s *Service) parseSections(content string) []specSection {
+	sectionRegex := regexp.MustCompile(`^##\s+(?:\d+\.\s*)?(.+)$`)
+	sections := []specSection{{}}
+	inFence := false
+
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		fence := strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+		if !inFence && !fence {
+			if matches := sectionRegex.FindStringSubmatch(line); len(matches) > 1 {
+				sections = append(sections, specSection{
+					Name: s.normalizeSectionName(strings.TrimSpace(matches[1])),
+					Line: i + 1,
+				})
+				continue
+			}
+		}
+
+		current := &sections[len(sections)-1]
+		current.Lines = append(current.Lines, sectionLine{Number: i + 1, Text: line, InCode: inFence || fence})
+		if fence {
+			inFence = !inFence
+		}
+	}
+
+	return sections
+}
+
+// sectionBody normaliza o corpo de uma seção para comparação: remove espaços nas
+// bordas, linhas em branco, comentários HTML e subtítulos, que sozinhos não contam como conteúdo
+func sectionBody(lines []sectionLine) string {
+	var body []string
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line.Text)
+		if !line.InCode {
+			trimmed = strings.TrimSpace(htmlCommentRegex.ReplaceAllString(trimmed, ""))
+		}
+		if trimmed == "" || (!line.InCode && strings.HasPrefix(trimmed, "#")) {
+			continue
+		}
+		body = append(body, trimmed)
+	}
+	return strings.Join(body, "\n")
+}
+
+// templateSections retorna o corpo normalizado de cada seção do template, procurado a partir do
+// diretório de specs root subindo no máximo até a raiz do projeto (diretório com .git). Sem
+// template no projeto, usa o template embutido.
+func (s *Service) templateSections(root string) map[string]string {
+	var data []byte
+	if dir, err := filepath.Abs(root); err == nil {
+		stop := baseline.ProjectRoot(s.fs, dir)
+		for ; ; dir = filepath.Dir(dir) {
+			if content, err := s.fs.ReadFile(filepath.Join(dir, TemplateFileName)); err == nil {
+				data = content
+				break
+			}
+			if dir == stop || filepath.Dir(dir) == dir {
+				break
+			}
+		}
+	}
+	if data == nil {
+		content, ok := templates.GetTemplate(TemplateFileName)
+		if !ok {
+			return nil
+		}
+		data = content
+	}
+
+	sections := make(map[string]string)
+	for _, section := range s.parseSections(string(data)) {
+		if section.Name == "" {
+			continue
+		}
+		if body := sectionBody(section.Lines); body != "" {
+			sections[section.Name] = body
+		}
+	}
+	return sections
+}
@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+const placeholderTemplate = `# Template
+
+## 1. Contexto e Objetivo
+TODO (descreva o problema)
+
+## 9. Testes
+- Unit: regras de negócio
+- Integração: fluxos principais
+`
+
+func placeholderSpec(dados, testes, migracao string) string {
+	return `# Test Spec
+
+## 1. Contexto e Objetivo
+Teste
+
+## 2. Requisitos Funcionais
+Teste
+
+## 3. Contratos e Interfaces
+Teste
+
+## 4. Fluxos e Estados
+Teste
+
+## 5. Dados
+` + dados + `
+
+## 6. NFRs (Não Funcionais)
+Teste
+
+## 7. Guardrails
+Teste
+
+## 8. Critérios de Aceite
+Teste
+
+## 9. Testes
+` + testes + `
+
+## 10. Migração / Rollback
+` + migracao + `
+
+## 11. Observações Operacionais
+Teste
+
+## 12. Abertos / Fora de Escopo
+Teste
+
+## Checklist Rápido (preencha antes de gerar código)
+- [x] Requisitos estão testáveis? Entradas/saídas precisas?
+- [x] Contratos de CLI/APIs têm formatos e códigos de saída definidos?
+- [x] Estados de erro e mensagens estão claros?
+- [x] Guardrails e convenções estão escritos?
+- [x] Critérios de aceite cobrem fluxos principais e erros?
+- [x] Migração/rollback definidos quando há mudança de estado?
+`
+}
+
+func TestService_Validate_Placeholders(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	templatePath := filepath.Join(tmpDir, TemplateFileName)
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+
+	if err := fs.WriteFile(templatePath, []byte(placeholderTemplate), 0644); err != nil {
+		t.Fatalf("falha ao criar template: %v", err)
+	}
+	spec := placeholderSpec(
+		"- Entidade: TODO\n- Campo `TODO` em código inline é ignorado",
+		"  - Unit: regras de negócio\n\n- Integração: fluxos principais",
+		"### 10.1 Estratégia",
+	)
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	result, err := service.Validate(ValidateOptions{Path: tmpDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	var vr, tr ValidationResult
+	for _, r := range result.Results {
+		if r.Path == specPath {
+			vr = r
+		} else {
+			tr = r
+		}
+	}
+
+	if len(tr.Placeholders) != 0 {
+		t.Errorf("o próprio template não deveria ser verificado: %v", tr.Placeholders)
+	}
+	if !vr.Valid {
+		t.Errorf("placeholders não deveriam gerar erros: %v", vr.Errors)
+	}
+	if vr.Complete {
+		t.Error("spec com placeholders não deveria estar completa, mesmo com checklist marcado")
+	}
+
+	expected := []string{
+		"texto de placeholder 'TODO'",
+		"seção 'Testes' idêntica ao template",
+		"seção 'Migração' está vazia",
+	}
+	if len(vr.Placeholders) != len(expected) {
+		t.Fatalf("esperado %d placeholders, obtido %v", len(expected), vr.Placeholders)
+	}
+	for i, msg := range expected {
+		if !strings.Contains(vr.Placeholders[i], msg) {
+			t.Errorf("esperado %q, obtido %q", msg, vr.Placeholders[i])
+		}
+	}
+}
+
+func TestService_Validate_TemplateWithinProject(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	// Template acima da raiz do projeto (diretório com .git) é ignorado
+	outside := t.TempDir()
+	specsDir := filepath.Join(outside, "repo", "specs")
+	specPath := filepath.Join(specsDir, "api", "01-test.spec.md")
+	if err := fs.MkdirAll(filepath.Join(outside, "repo", ".git"), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	if err := fs.MkdirAll(filepath.Dir(specPath), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	spec := placeholderSpec("Teste", "- Unit: regras de negócio\n- Integração: fluxos principais", "Teste")
+	files := map[string]string{
+		filepath.Join(outside, TemplateFileName): placeholderTemplate,
+		specPath:                                 spec,
+	}
+	for path, content := range files {
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", path, err)
+		}
+	}
+
+	placeholders := func(path string) []string {
+		t.Helper()
+		result, err := service.Validate(ValidateOptions{Path: path})
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		return result.Results[0].Placeholders
+	}
+
+	if got := placeholders(specsDir); len(got) != 0 {
+		t.Errorf("template fora do projeto não deveria ser usado: %v", got)
+	}
+
+	// Template na raiz do diretório de specs vale para specs em subdiretórios
+	if err := fs.WriteFile(filepath.Join(specsDir, TemplateFileName), []byte(placeholderTemplate), 0644); err != nil {
+		t.Fatalf("falha ao criar template: %v", err)
+	}
+	for _, path := range []string{specsDir, specPath} {
+		if got := placeholders(path); len(got) != 1 || !strings.Contains(got[0], "seção 'Testes' idêntica ao template") {
+			t.Errorf("%s: esperada seção idêntica ao template, obtido %v", path, got)
+		}
+	}
+}
+
+func TestService_Validate_PlaceholdersSuppressed(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+
+	spec := placeholderSpec(
+		"<!-- specs-disable-next-line placeholder -->\n- Entidade: TODO",
+		"Teste",
+		"<!-- specs-disable: empty-section:Migração -->",
+	)
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	result, err := service.Validate(ValidateOptions{Path: specPath})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	vr := result.Results[0]
+	if len(vr.Placeholders) != 0 {
+		t.Errorf("placeholders suprimidos não deveriam ser reportados: %v", vr.Placeholders)
+	}
+	if len(vr.UnusedSuppressions) != 0 {
+		t.Errorf("supressões deveriam ter sido utilizadas: %v", vr.UnusedSuppressions)
+	}
+	if !vr.Complete {
+		t.Error("spec deveria estar completa")
+	}
+}
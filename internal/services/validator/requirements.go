@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	requirementDefRegex = regexp.MustCompile(`^-\s+\*\*(RF-?\d[^\s*:]*)([^*]*)`) // RF seguido de número; "RFC 7231" não é requisito
+	requirementIDRegex  = regexp.MustCompile(`^RF(\d{2,})$`)
+	requirementRefRegex = regexp.MustCompile(`\bRF\d+\b`)
+)
+
+// Requirement representa um requisito funcional definido na seção "Requisitos Funcionais"
+type Requirement struct {
+	ID    string // Ex.: "RF04"
+	Title string // Ex.: "Validação de Estrutura"
+	Line  int    // Linha da definição (1-based)
+}
+
+// RequirementReference é uma menção a um requisito fora da sua definição
+type RequirementReference struct {
+	ID      string
+	Section string // Nome normalizado da seção (ex.: "Critérios de Aceite")
+	Line    int
+}
+
+// requirementIssue é um problema de integridade de requisitos
+type requirementIssue struct {
+	Line    int
+	Message string
+}
+
+// Requirements extrai os requisitos funcionais (bullets `- **RFNN ...`) da seção
+// "Requisitos Funcionais", na ordem em que aparecem
+func (s *Service) Requirements(content string) []Requirement {
+	var requirements []Requirement
+	for _, section := range s.parseSections(content) {
+		if section.Name != "Requisitos Funcionais" {
+			continue
+		}
+		for _, line := range section.Lines {
+			if line.InCode {
+				continue
+			}
+			if matches := requirementDefRegex.FindStringSubmatch(strings.TrimSpace(line.Text)); matches != nil {
+				requirements = append(requirements, Requirement{
+					ID:    matches[1],
+					Title: strings.Trim(matches[2], " -–:"),
+					Line:  line.Number,
+				})
+			}
+		}
+	}
+	return requirements
+}
+
+// checkRequirements verifica a integridade dos IDs de requisitos: formato RFNN,
+// unicidade, sequência sem lacunas e referências a requisitos inexistentes
+func (s *Service) checkRequirements(content string) []requirementIssue {
+	var issues []requirementIssue
+
+	requirements := s.Requirements(content)
+	defined := make(map[string]int, len(requirements))
+	expected := 1
+
+	for _, req := range requirements {
+		matches := requirementIDRegex.FindStringSubmatch(req.ID)
+		if matches == nil {
+			issues = append(issues, requirementIssue{req.Line, fmt.Sprintf("ID de requisito malformado '%s' (esperado RFNN, ex.: RF01)", req.ID)})
+			continue
+		}
+		// A linha da primeira definição não entra na mensagem, para que o fingerprint do
+		// baseline não mude quando a spec é editada acima dela
+		if _, ok := defined[req.ID]; ok {
+			issues = append(issues, requirementIssue{req.Line, fmt.Sprintf("requisito %s duplicado", req.ID)})
+			continue
+		}
+		defined[req.ID] = req.Line
+
+		number, _ := strconv.Atoi(matches[1])
+		if number != expected {
+			issues = append(issues, requirementIssue{req.Line, fmt.Sprintf("requisito %s fora de sequência (esperado RF%02d)", req.ID, expected)})
+		}
+		expected = number + 1
+	}
+
+	// Referências a requisitos no restante da spec (critérios de aceite, testes, etc.)
+	for _, ref := range s.RequirementReferences(content) {
+		if _, ok := defined[ref.ID]; !ok {
+			issues = append(issues, requirementIssue{ref.Line, fmt.Sprintf("referência a requisito inexistente %s", ref.ID)})
+		}
+	}
+
+	return issues
+}
+
+// RequirementReferences retorna as menções a requisitos (RFNN) fora das linhas de definição,
+// ignorando blocos de código e código inline
+func (s *Service) RequirementReferences(content string) []RequirementReference {
+	definitionLines := make(map[int]bool)
+	for _, req := range s.Requirements(content) {
+		definitionLines[req.Line] = true
+	}
+
+	var refs []RequirementReference
+	for _, section := range s.parseSections(content) {
+		for _, line := range section.Lines {
+			if line.InCode || definitionLines[line.Number] {
+				continue
+			}
+			text := inlineCodeRegex.ReplaceAllString(line.Text, "")
+			for _, id := range requirementRefRegex.FindAllString(text, -1) {
+				refs = append(refs, RequirementReference{ID: id, Section: section.Name, Line: line.Number})
+			}
+		}
+	}
+	return refs
+}
@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/baseline"
+)
+
+func requirementsSpec(requisitos, criterios string) string {
+	return strings.Replace(placeholderSpec("Teste", "Teste", "Teste"), "## 2. Requisitos Funcionais\nTeste", "## 2. Requisitos Funcionais\n"+requisitos, 1) +
+		"\n## Critérios de Aceite (extras)\n" + criterios + "\n"
+}
+
+func TestService_Requirements(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+
+	content := requirementsSpec("- **RF01 - Primeiro:**\n  - detalhe\n```\n- **RF09 - Em código:**\n```\n- **RFC 7231** define os métodos HTTP\n- **RFs** opcionais\n- **RF02 - Segundo:**", "")
+	requirements := service.Requirements(content)
+
+	if len(requirements) != 2 {
+		t.Fatalf("esperado 2 requisitos, obtido %v", requirements)
+	}
+	if requirements[0].ID != "RF01" || requirements[1].ID != "RF02" {
+		t.Errorf("IDs inesperados: %v", requirements)
+	}
+	// Siglas iniciadas por RF (RFC, RFs) não são requisitos; IDs numéricos malformados são
+	if got := service.Requirements(requirementsSpec("- **RF-2 - Hífen:**", "")); len(got) != 1 || got[0].ID != "RF-2" {
+		t.Errorf("ID malformado deveria ser extraído para ser reportado, obtido %v", got)
+	}
+}
+
+func TestService_Validate_RequirementIntegrity(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+
+	spec := requirementsSpec(
+		"- **RF01 - Primeiro:**\n- **RF01 - Repetido:**\n- **RF03 - Pulou:**\n- **RF4 - Malformado:**",
+		"- [x] Cobre RF01 e RF03\n- [x] Cobre RF07\n- [x] `RF99` em código inline é ignorado",
+	)
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	result, err := service.Validate(ValidateOptions{Path: specPath})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	vr := result.Results[0]
+	if vr.Valid {
+		t.Fatal("spec com requisitos inconsistentes não deveria ser válida")
+	}
+
+	expected := []string{
+		"requisito RF01 duplicado",
+		"requisito RF03 fora de sequência (esperado RF02)",
+		"ID de requisito malformado 'RF4'",
+		"referência a requisito inexistente RF07",
+	}
+	if len(vr.Errors) != len(expected) {
+		t.Fatalf("esperado %d erros, obtido %v", len(expected), vr.Errors)
+	}
+	for i, msg := range expected {
+		if !strings.HasPrefix(vr.Errors[i], "linha ") || !strings.Contains(vr.Errors[i], msg) {
+			t.Errorf("esperado %q com número de linha, obtido %q", msg, vr.Errors[i])
+		}
+	}
+}
+
+func TestService_Validate_RequirementBaselineIgnoresLine(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+
+	spec := requirementsSpec("- **RF01 - Primeiro:**\n- **RF01 - Repetido:**", "- [x] Cobre RF02")
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+	before, err := service.Validate(ValidateOptions{Path: tmpDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	b, err := baseline.NewService(fs).Save(tmpDir, BaselineEntries(before.Results[0], tmpDir))
+	if err != nil {
+		t.Fatalf("falha ao salvar baseline: %v", err)
+	}
+
+	// Deslocar o requisito duplicado e a referência inválida para outras linhas
+	spec = strings.Replace(spec, "- **RF01 - Primeiro:**", "Texto novo.\n\n- **RF01 - Primeiro:**", 1)
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao alterar spec: %v", err)
+	}
+	result, err := service.Validate(ValidateOptions{Path: tmpDir, Baseline: b})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if result.WithErrors != 0 || result.Suppressed != 2 {
+		t.Errorf("esperados erros suprimidos pelo baseline, obtido %v", result.Results[0].Errors)
+	}
+}
@@ -5,10 +5,14 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/baseline"
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/suppression"
 )
 
 // Service gerencia validação de specs
@@ -39,25 +43,35 @@ var RequiredSections = []string{
 
 // ValidateOptions contém opções para validação
 type ValidateOptions struct {
-	Path string // Caminho para arquivo ou diretório
+	Path     string             // Caminho para arquivo ou diretório
+	Files    []string           // Se informado, restringe validação a estes arquivos dentro do caminho
+	Baseline *baseline.Baseline // Se informado, erros registrados no baseline não são reportados
 }
 
 // ValidationResult contém resultado da validação de uma spec
 type ValidationResult struct {
-	Path      string
-	Valid     bool
-	Complete  bool // Todos os itens do checklist marcados
-	Errors    []string
-	Warnings  []string
-	Checklist ChecklistInfo
+	Path       string
+	Valid      bool
+	Complete   bool // Todos os itens do checklist marcados
+	Errors     []string
+	Warnings   []string
+	Checklist  ChecklistInfo
+	Suppressed int // Erros suprimidos pelo baseline
+
+	// Conteúdo de template não preenchido (TODO, seções vazias ou idênticas ao template).
+	// Não torna a spec inválida, mas impede que seja considerada completa.
+	Placeholders []string
+
+	// Diretivas de supressão inline não utilizadas ou com regra desconhecida
+	UnusedSuppressions []string
 }
 
 // ChecklistInfo contém informações sobre o checklist
 type ChecklistInfo struct {
-	Found      bool
-	ItemCount  int
-	MarkedCount int
-	ValidFormat bool
+	Found       bool `json:"found"`
+	ItemCount   int  `json:"item_count"`
+	MarkedCount int  `json:"marked_count"`
+	ValidFormat bool `json:"valid_format"`
 }
 
 // ValidateResult contém resultado agregado de validação
@@ -67,6 +81,11 @@ type ValidateResult struct {
 	Complete     int
 	Incomplete   int
 	WithErrors   int
+	Suppressed   int
+
+	UnusedSuppressions int
+
+	template map[string]string // Seções do template, reaproveitadas por Revalidate
 }
 
 // Validate valida um arquivo ou diretório de specs
@@ -107,16 +126,81 @@ func (s *Service) Validate(opts ValidateOptions) (*ValidateResult, error) {
 		specFiles = []string{path}
 	}
 
-	// Validar cada arquivo
-	result := &ValidateResult{
-		Results: make([]ValidationResult, 0, len(specFiles)),
+	if opts.Files != nil {
+		specFiles = RestrictTo(specFiles, opts.Files)
 	}
 
+	// Template resolvido uma única vez, a partir do diretório de specs
+	root := path
+	if !stat.IsDir() {
+		root = filepath.Dir(path)
+	}
+	template := s.templateSections(root)
+
+	// Validar cada arquivo
+	results := make([]ValidationResult, 0, len(specFiles))
 	for _, file := range specFiles {
-		vr := s.validateFile(file)
-		result.Results = append(result.Results, vr)
-		result.Total++
+		results = append(results, s.validateFileWithBaseline(file, opts.Baseline, template))
+	}
+
+	result := Summarize(results)
+	result.template = template
+	return result, nil
+}
+
+// Revalidate revalida apenas os arquivos alterados, reaproveitando os demais resultados.
+// Arquivos alterados que não existem mais são removidos do resultado.
+func (s *Service) Revalidate(previous *ValidateResult, changed []string, b *baseline.Baseline) *ValidateResult {
+	changedSet := make(map[string]bool, len(changed))
+	template := previous.template
+	for _, file := range changed {
+		changedSet[filepath.Clean(file)] = true
+		// Template alterado: as comparações com o template passam a usar o novo conteúdo
+		if filepath.Base(file) == TemplateFileName {
+			template = s.templateSections(filepath.Dir(file))
+		}
+	}
+
+	results := make([]ValidationResult, 0, len(previous.Results)+len(changed))
+	seen := make(map[string]bool)
+	for _, vr := range previous.Results {
+		path := filepath.Clean(vr.Path)
+		seen[path] = true
+		if !changedSet[path] {
+			results = append(results, vr)
+			continue
+		}
+		if s.fs.Exists(vr.Path) {
+			results = append(results, s.validateFileWithBaseline(vr.Path, b, template))
+		}
+	}
+
+	// Arquivos novos
+	for _, file := range changed {
+		if !seen[filepath.Clean(file)] && strings.HasSuffix(file, ".spec.md") && s.fs.Exists(file) {
+			results = append(results, s.validateFileWithBaseline(file, b, template))
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Path < results[j].Path
+	})
+
+	result := Summarize(results)
+	result.template = template
+	return result
+}
+
+// Summarize agrega resultados individuais em um ValidateResult
+func Summarize(results []ValidationResult) *ValidateResult {
+	result := &ValidateResult{
+		Results: results,
+		Total:   len(results),
+	}
 
+	for _, vr := range results {
+		result.Suppressed += vr.Suppressed
+		result.UnusedSuppressions += len(vr.UnusedSuppressions)
 		if len(vr.Errors) > 0 {
 			result.WithErrors++
 		} else if vr.Complete {
@@ -126,7 +210,25 @@ func (s *Service) Validate(opts ValidateOptions) (*ValidateResult, error) {
 		}
 	}
 
-	return result, nil
+	return result
+}
+
+// RestrictTo mantém apenas os arquivos presentes em allowed (comparação por caminho absoluto)
+func RestrictTo(files []string, allowed []string) []string {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, file := range allowed {
+		if abs, err := filepath.Abs(file); err == nil {
+			allowedSet[abs] = true
+		}
+	}
+
+	restricted := make([]string, 0, len(files))
+	for _, file := range files {
+		if abs, err := filepath.Abs(file); err == nil && allowedSet[abs] {
+			restricted = append(restricted, file)
+		}
+	}
+	return restricted
 }
 
 // findSpecFiles encontra todos os arquivos .spec.md recursivamente
@@ -144,8 +246,53 @@ func (s *Service) findSpecFiles(root string) ([]string, error) {
 	return files, err
 }
 
-// validateFile valida um arquivo de spec
-func (s *Service) validateFile(path string) ValidationResult {
+// BaselineEntries converte erros de validação em entradas de baseline com arquivo relativo a root
+func BaselineEntries(vr ValidationResult, root string) []baseline.Entry {
+	file := baseline.RelPath(root, vr.Path)
+	entries := make([]baseline.Entry, 0, len(vr.Errors))
+	for _, msg := range vr.Errors {
+		entries = append(entries, baseline.NewEntry(baseline.SourceValidate, "", file, baselineMessage(msg)))
+	}
+	return entries
+}
+
+var lineMessageRegex = regexp.MustCompile(`^linha \d+: `)
+
+// baselineMessage remove o prefixo "linha N: " para que o fingerprint não dependa da posição do problema
+func baselineMessage(msg string) string {
+	return lineMessageRegex.ReplaceAllString(msg, "")
+}
+
+// validateFileWithBaseline valida um arquivo e remove erros registrados no baseline
+func (s *Service) validateFileWithBaseline(path string, b *baseline.Baseline, template map[string]string) ValidationResult {
+	result := s.validateFile(path, template)
+	if b == nil || len(result.Errors) == 0 {
+		return result
+	}
+
+	matcher := b.NewMatcher()
+	file := b.RelPath(path)
+	kept := make([]string, 0, len(result.Errors))
+	for _, msg := range result.Errors {
+		if matcher.Suppress(baseline.NewEntry(baseline.SourceValidate, "", file, baselineMessage(msg))) {
+			result.Suppressed++
+			continue
+		}
+		kept = append(kept, msg)
+	}
+	result.Errors = kept
+
+	// Sem erros restantes, a spec volta a ser avaliada apenas pelo checklist
+	if len(result.Errors) == 0 {
+		result.Valid = true
+		result.Complete = result.Checklist.MarkedCount == 6 && len(result.Placeholders) == 0
+	}
+
+	return result
+}
+
+// validateFile valida um arquivo de spec; template são as seções do template resolvido pela validação
+func (s *Service) validateFile(path string, template map[string]string) ValidationResult {
 	result := ValidationResult{
 		Path:   path,
 		Valid:  true,
@@ -177,53 +324,85 @@ func (s *Service) validateFile(path string) ValidationResult {
 		return result
 	}
 
-	// Validar estrutura básica
-	if err := s.validateStructure(content); err != nil {
+	// Diretivas de supressão inline (ex.: <!-- specs-disable: missing-section:Migração -->)
+	suppressions := suppression.Parse(content)
+	addError := func(rule string, line int, msg string) {
+		if suppressions.Suppresses(rule, line) {
+			return
+		}
 		result.Valid = false
-		result.Errors = append(result.Errors, err.Error())
+		result.Errors = append(result.Errors, msg)
 	}
 
-	// Validar seções obrigatórias
+	// Validar estrutura básica
+	if line, err := s.validateStructure(content); err != nil {
+		addError(suppression.RuleStructure, line, fmt.Sprintf("linha %d: %v", line, err))
+	}
+
+	// Validar seções obrigatórias (seção ausente não tem linha: apenas a supressão do
+	// arquivo inteiro se aplica)
 	missingSections := s.validateRequiredSections(content)
-	if len(missingSections) > 0 {
-		result.Valid = false
-		for _, section := range missingSections {
-			result.Errors = append(result.Errors, fmt.Sprintf("seção '%s' faltando", section))
-		}
+	for _, section := range missingSections {
+		addError(suppression.RuleMissingSection+":"+section, 0, fmt.Sprintf("seção '%s' faltando", section))
+	}
+
+	// Validar IDs de requisitos (formato, unicidade, sequência e referências) e de critérios de aceite
+	for _, issue := range append(s.checkRequirements(content), s.checkCriteria(content)...) {
+		addError(suppression.RuleRequirements, issue.Line, fmt.Sprintf("linha %d: %s", issue.Line, issue.Message))
 	}
 
 	// Validar checklist
 	checklistInfo := s.validateChecklist(content)
 	result.Checklist = checklistInfo
 
+	// Erros de checklist apontam para o título da seção Checklist, quando existe
+	checklistLine, _ := checklistStart(strings.Split(content, "\n"))
 	if !checklistInfo.Found {
-		result.Valid = false
-		result.Errors = append(result.Errors, "checklist não encontrado")
+		if checklistLine > 0 {
+			addError(suppression.RuleChecklist, checklistLine, fmt.Sprintf("linha %d: checklist não encontrado", checklistLine))
+		} else {
+			addError(suppression.RuleChecklist, 0, "checklist não encontrado")
+		}
 	} else if !checklistInfo.ValidFormat {
-		result.Valid = false
-		result.Errors = append(result.Errors, fmt.Sprintf("checklist com formato inválido (esperado 6 itens, encontrado %d)", checklistInfo.ItemCount))
+		addError(suppression.RuleChecklist, checklistLine, fmt.Sprintf("linha %d: checklist com formato inválido (esperado 6 itens, encontrado %d)", checklistLine, checklistInfo.ItemCount))
 	} else if checklistInfo.MarkedCount < 6 {
 		result.Warnings = append(result.Warnings, fmt.Sprintf("checklist incompleto (%d/6 itens)", checklistInfo.MarkedCount))
 	}
 
-	// Determinar se está completa (sem erros e checklist completo)
-	result.Complete = result.Valid && checklistInfo.MarkedCount == 6
+	// Detectar conteúdo de template não preenchido
+	result.Placeholders = s.checkPlaceholders(path, content, suppressions, template)
+
+	// Reportar supressões sem efeito
+	for _, d := range suppressions.Unused(suppression.ValidatorRules) {
+		result.UnusedSuppressions = append(result.UnusedSuppressions, fmt.Sprintf("linha %d: supressão não utilizada: %s", d.Line, d.Rule))
+	}
+	for _, d := range suppressions.Unknown() {
+		result.UnusedSuppressions = append(result.UnusedSuppressions, fmt.Sprintf("linha %d: regra desconhecida em supressão: %s", d.Line, d.Rule))
+	}
+
+	// Determinar se está completa (sem erros, checklist completo e sem placeholders)
+	result.Complete = result.Valid && checklistInfo.MarkedCount == 6 && len(result.Placeholders) == 0
 
 	return result
 }
 
-// validateStructure valida estrutura básica do arquivo
-func (s *Service) validateStructure(content string) error {
+// validateStructure valida estrutura básica do arquivo, retornando também a linha (1-based)
+// do problema: a primeira linha após o frontmatter ou o título que pulou um nível
+func (s *Service) validateStructure(content string) (int, error) {
 	lines := strings.Split(content, "\n")
 
+	// Frontmatter (metadados) precede o título principal
+	offset := metadata.Parse(content).EndLine
+	lines = lines[offset:]
+
 	// Verificar se começa com título principal (#)
 	if len(lines) == 0 || !strings.HasPrefix(strings.TrimSpace(lines[0]), "# ") {
-		return fmt.Errorf("arquivo deve começar com título principal (#)")
+		return offset + 1, fmt.Errorf("arquivo deve começar com título principal (#)")
 	}
 
 	// Verificar hierarquia de títulos (não pular níveis)
 	prevLevel := 0
-	for _, line := range lines {
+	for i, line := range lines {
 		trimmed := strings.TrimSpace(line)
 		if strings.HasPrefix(trimmed, "#") {
 			level := 0
@@ -236,14 +415,14 @@ func (s *Service) validateStructure(content string) error {
 			}
 			if level > 0 && level <= 6 {
 				if prevLevel > 0 && level > prevLevel+1 {
-					return fmt.Errorf("hierarquia de títulos inválida: pulou do nível %d para %d", prevLevel, level)
+					return offset + i + 1, fmt.Errorf("hierarquia de títulos inválida: pulou do nível %d para %d", prevLevel, level)
 				}
 				prevLevel = level
 			}
 		}
 	}
 
-	return nil
+	return 0, nil
 }
 
 // validateRequiredSections verifica se todas as seções obrigatórias estão presentes
@@ -335,6 +514,26 @@ func (s *Service) normalizeSectionName(name string) string {
 	return name
 }
 
+// ChecklistItem é um item do checklist rápido (seção "Checklist" após "Abertos")
+type ChecklistItem struct {
+	Text    string
+	Line    int // Linha do item (1-based)
+	Checked bool
+}
+
+var checklistItemRegex = regexp.MustCompile(`^-\s+\[([ x])\]\s+(.+)$`)
+
+// ChecklistItems extrai os itens do checklist rápido, na ordem em que aparecem (os mesmos
+// itens contados pela validação do checklist)
+func (s *Service) ChecklistItems(content string) []ChecklistItem {
+	lines := strings.Split(content, "\n")
+	_, start := checklistStart(lines)
+	if start < 0 {
+		return nil
+	}
+	return checklistItems(lines, start)
+}
+
 // validateChecklist valida o checklist da spec
 func (s *Service) validateChecklist(content string) ChecklistInfo {
 	info := ChecklistInfo{
@@ -344,11 +543,33 @@ func (s *Service) validateChecklist(content string) ChecklistInfo {
 		ValidFormat: false,
 	}
 
-	// Procurar por seção "Checklist" (Checklist Rápido) após seção "Abertos"
 	lines := strings.Split(content, "\n")
+	_, start := checklistStart(lines)
+	if start < 0 {
+		return info
+	}
+	info.Found = true
+
+	// Contar itens do checklist
+	for _, item := range checklistItems(lines, start) {
+		info.ItemCount++
+		if item.Checked {
+			info.MarkedCount++
+		}
+	}
+
+	// Validar formato (deve ter exatamente 6 itens)
+	info.ValidFormat = info.ItemCount == 6
+
+	return info
+}
+
+// checklistStart retorna a linha (1-based) do título da seção "Checklist" (Checklist Rápido)
+// após a seção "Abertos", ou 0 se não houver, e o índice da primeira linha do checklist
+// (linha com "- ["), ou -1 se não houver checklist
+func checklistStart(lines []string) (int, int) {
 	foundAbertos := false
-	checklistSectionFound := false
-	checklistStart := -1
+	heading := 0
 
 	for i, line := range lines {
 		trimmed := strings.TrimSpace(line)
@@ -358,46 +579,32 @@ func (s *Service) validateChecklist(content string) ChecklistInfo {
 		}
 		// Após "Abertos", procurar por seção "Checklist"
 		if foundAbertos && strings.HasPrefix(trimmed, "##") && strings.Contains(strings.ToLower(trimmed), "checklist") {
-			checklistSectionFound = true
+			heading = i + 1
 			continue
 		}
 		// Procurar início do checklist (linha com "- [") após seção "Checklist"
-		if checklistSectionFound && strings.HasPrefix(trimmed, "- [") {
-			checklistStart = i
-			info.Found = true
-			break
+		if heading > 0 && strings.HasPrefix(trimmed, "- [") {
+			return heading, i
 		}
 	}
+	return heading, -1
+}
 
-	if !info.Found {
-		return info
-	}
-
-	// Contar itens do checklist
-	itemRegex := regexp.MustCompile(`^-\s+\[([ x])\]\s+(.+)$`)
-	for i := checklistStart; i < len(lines); i++ {
+// checklistItems extrai os itens a partir do início do checklist até a próxima seção (##)
+func checklistItems(lines []string, start int) []ChecklistItem {
+	var items []ChecklistItem
+	for i := start; i < len(lines); i++ {
 		trimmed := strings.TrimSpace(lines[i])
-		
-		// Parar se encontrar próxima seção (##) ou linha vazia seguida de seção
 		if strings.HasPrefix(trimmed, "##") {
 			break
 		}
-
-		// Verificar se é item de checklist
-		matches := itemRegex.FindStringSubmatch(trimmed)
-		if len(matches) > 0 {
-			info.ItemCount++
-			if strings.TrimSpace(matches[1]) == "x" {
-				info.MarkedCount++
-			}
-		} else if trimmed != "" && !strings.HasPrefix(trimmed, "-") {
-			// Se encontrou linha não vazia que não é item de checklist, pode ser fim do checklist
-			// Mas continuar para pegar todos os itens consecutivos
+		if matches := checklistItemRegex.FindStringSubmatch(trimmed); matches != nil {
+			items = append(items, ChecklistItem{
+				Text:    strings.TrimSpace(matches[2]),
+				Line:    i + 1,
+				Checked: matches[1] == "x",
+			})
 		}
 	}
-
-	// Validar formato (deve ter exatamente 6 itens)
-	info.ValidFormat = info.ItemCount == 6
-
-	return info
+	return items
 }
@@ -1,10 +1,13 @@
 package validator
 
 import (
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/dreibox/specs/internal/adapters"
+	"github.com/dreibox/specs/internal/services/baseline"
 )
 
 func TestService_Validate_ValidSpec(t *testing.T) {
@@ -337,3 +340,286 @@ func TestService_Validate_InvalidExtension(t *testing.T) {
 		t.Error("deveria retornar erro para arquivo sem extensão .spec.md")
 	}
 }
+
+func TestService_Revalidate(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	spec1 := filepath.Join(tmpDir, "01-test.spec.md")
+	spec2 := filepath.Join(tmpDir, "02-test.spec.md")
+	spec3 := filepath.Join(tmpDir, "03-test.spec.md")
+
+	if err := fs.WriteFile(spec1, []byte("# Spec sem seções"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec1: %v", err)
+	}
+	if err := fs.WriteFile(spec2, []byte("# Spec sem seções"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec2: %v", err)
+	}
+
+	result, err := service.Validate(ValidateOptions{Path: tmpDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if result.WithErrors != 2 {
+		t.Fatalf("esperado 2 specs com erros, obtido %d", result.WithErrors)
+	}
+
+	// Remover spec2 e criar spec3 vazia
+	if err := os.Remove(spec2); err != nil {
+		t.Fatalf("falha ao remover spec2: %v", err)
+	}
+	if err := fs.WriteFile(spec3, []byte(""), 0644); err != nil {
+		t.Fatalf("falha ao criar spec3: %v", err)
+	}
+
+	updated := service.Revalidate(result, []string{spec2, spec3}, nil)
+	if updated.Total != 2 {
+		t.Fatalf("esperado 2 specs, obtido %d", updated.Total)
+	}
+	if updated.Results[0].Path != spec1 || updated.Results[1].Path != spec3 {
+		t.Errorf("resultados inesperados: %s, %s", updated.Results[0].Path, updated.Results[1].Path)
+	}
+	if len(updated.Results[1].Errors) == 0 || updated.Results[1].Errors[0] != "arquivo está vazio" {
+		t.Errorf("esperado erro de arquivo vazio para spec3, obtido %v", updated.Results[1].Errors)
+	}
+}
+
+func TestService_Validate_RestrictToFiles(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	spec1 := filepath.Join(tmpDir, "01-test.spec.md")
+	spec2 := filepath.Join(tmpDir, "02-test.spec.md")
+	for _, spec := range []string{spec1, spec2} {
+		if err := fs.WriteFile(spec, []byte("# Spec"), 0644); err != nil {
+			t.Fatalf("falha ao criar spec: %v", err)
+		}
+	}
+
+	result, err := service.Validate(ValidateOptions{
+		Path:  tmpDir,
+		Files: []string{spec2, filepath.Join(tmpDir, "fora-do-caminho.spec.md")},
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if result.Total != 1 || result.Results[0].Path != spec2 {
+		t.Errorf("esperado apenas %s, obtido %d resultado(s)", spec2, result.Total)
+	}
+}
+
+func TestService_Validate_Baseline(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+	if err := fs.WriteFile(specPath, []byte("# Spec sem seções"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	before, err := service.Validate(ValidateOptions{Path: tmpDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	b, err := baseline.NewService(fs).Save(tmpDir, BaselineEntries(before.Results[0], tmpDir))
+	if err != nil {
+		t.Fatalf("falha ao salvar baseline: %v", err)
+	}
+
+	result, err := service.Validate(ValidateOptions{Path: tmpDir, Baseline: b})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if result.WithErrors != 0 {
+		t.Errorf("esperado 0 specs com erros, obtido %d", result.WithErrors)
+	}
+	if result.Suppressed != len(before.Results[0].Errors) {
+		t.Errorf("esperado %d erros suprimidos, obtido %d", len(before.Results[0].Errors), result.Suppressed)
+	}
+}
+
+func TestService_Validate_InlineSuppression(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+
+	spec := `# Test Spec
+<!-- specs-disable: missing-section:Migração, missing-section:Dados -->
+
+## 1. Contexto e Objetivo
+Teste
+
+## 2. Requisitos Funcionais
+Teste
+
+## 3. Contratos e Interfaces
+Teste
+
+## 4. Fluxos e Estados
+Teste
+
+## 6. NFRs (Não Funcionais)
+Teste
+
+## 7. Guardrails
+Teste
+
+## 8. Critérios de Aceite
+Teste
+
+## 9. Testes
+Teste
+
+## 11. Observações Operacionais
+Teste
+
+## 12. Abertos / Fora de Escopo
+Teste
+
+## Checklist Rápido (preencha antes de gerar código)
+- [x] Requisitos estão testáveis? Entradas/saídas precisas?
+- [x] Contratos de CLI/APIs têm formatos e códigos de saída definidos?
+- [x] Estados de erro e mensagens estão claros?
+- [x] Guardrails e convenções estão escritos?
+- [x] Critérios de aceite cobrem fluxos principais e erros?
+- [x] Migração/rollback definidos quando há mudança de estado?
+`
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	result, err := service.Validate(ValidateOptions{Path: specPath})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	vr := result.Results[0]
+	if len(vr.Errors) != 0 {
+		t.Errorf("seções suprimidas não deveriam gerar erros: %v", vr.Errors)
+	}
+	if !vr.Complete {
+		t.Error("spec deveria estar completa")
+	}
+
+	// Seção presente torna a supressão desnecessária
+	spec = strings.Replace(spec, "## 6. NFRs", "## 5. Dados\nTeste\n\n## 6. NFRs", 1)
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao alterar spec: %v", err)
+	}
+	result, err = service.Validate(ValidateOptions{Path: specPath})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	unused := result.Results[0].UnusedSuppressions
+	if len(unused) != 1 || !strings.Contains(unused[0], "missing-section:Dados") {
+		t.Errorf("esperado supressão não utilizada para Dados, obtido %v", unused)
+	}
+}
+
+func TestService_Validate_NextLineSuppression(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+	spec := placeholderSpec("Teste", "Teste", "Teste")
+	spec = strings.Replace(spec, "## 1. Contexto e Objetivo\nTeste\n", "## 1. Contexto e Objetivo\nTeste\n\n#### Detalhe\nTeste\n", 1)
+	spec = strings.Replace(spec, "- [x] Guardrails e convenções estão escritos?\n", "", 1)
+	spec = strings.Replace(spec, "## 11. Observações Operacionais\nTeste\n\n", "", 1)
+
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+	result, err := service.Validate(ValidateOptions{Path: specPath})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	expected := []string{
+		"linha 6: hierarquia de títulos inválida: pulou do nível 2 para 4",
+		"seção 'Observações Operacionais' faltando",
+		"linha 39: checklist com formato inválido (esperado 6 itens, encontrado 5)",
+	}
+	if got := strings.Join(result.Results[0].Errors, "; "); got != strings.Join(expected, "; ") {
+		t.Fatalf("erros inesperados:\n  obtido:   %s\n  esperado: %s", got, strings.Join(expected, "; "))
+	}
+
+	// Erros com linha são suprimidos pela diretiva na linha anterior; seção ausente não tem
+	// linha e só é suprimida pela diretiva do arquivo inteiro
+	spec = strings.Replace(spec, "#### Detalhe", "<!-- specs-disable-next-line structure -->\n#### Detalhe", 1)
+	spec = strings.Replace(spec, "## 12. Abertos", "<!-- specs-disable-next-line missing-section -->\n## 12. Abertos", 1)
+	spec = strings.Replace(spec, "## Checklist Rápido", "<!-- specs-disable-next-line checklist -->\n## Checklist Rápido", 1)
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao alterar spec: %v", err)
+	}
+	result, err = service.Validate(ValidateOptions{Path: specPath})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	vr := result.Results[0]
+	if len(vr.Errors) != 1 || vr.Errors[0] != "seção 'Observações Operacionais' faltando" {
+		t.Errorf("esperado apenas o erro de seção faltando, obtido %v", vr.Errors)
+	}
+	if len(vr.UnusedSuppressions) != 1 || !strings.Contains(vr.UnusedSuppressions[0], "missing-section") {
+		t.Errorf("esperado supressão não utilizada para missing-section, obtido %v", vr.UnusedSuppressions)
+	}
+}
+
+func TestService_Validate_Frontmatter(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specPath := filepath.Join(tmpDir, "01-test.spec.md")
+	spec := "---\nstatus: deprecated\n# comentário YAML não é título\n---\n" + placeholderSpec("Teste", "Teste", "Teste")
+	if err := fs.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	result, err := service.Validate(ValidateOptions{Path: specPath})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !result.Results[0].Valid {
+		t.Errorf("spec com frontmatter deveria ser válida, erros: %v", result.Results[0].Errors)
+	}
+}
+
+func TestService_ChecklistItems(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+
+	content := "# 01 - Teste\n\n## 8. Critérios de Aceite\n\n- [x] Não é do checklist\n\n## 12. Abertos\n\n### Checklist Rápido\n\n- [x] Primeiro\n- [ ] Segundo\n"
+	items := service.ChecklistItems(content)
+
+	if len(items) != 2 {
+		t.Fatalf("esperado 2 itens, obtido %v", items)
+	}
+	if items[0].Text != "Primeiro" || !items[0].Checked || items[0].Line != 11 {
+		t.Errorf("primeiro item inesperado: %+v", items[0])
+	}
+	if items[1].Text != "Segundo" || items[1].Checked {
+		t.Errorf("segundo item inesperado: %+v", items[1])
+	}
+	if items := service.ChecklistItems("# 01 - Teste\n\n- [x] Solto\n"); items != nil {
+		t.Errorf("sem seção de checklist deveria retornar nil, obtido %v", items)
+	}
+}
+
+func TestRestrictTo(t *testing.T) {
+	dir := t.TempDir()
+	inDiff := filepath.Join(dir, "02-modified.spec.md")
+	outside := filepath.Join(dir, "01-base.spec.md")
+	files := []string{outside, inDiff}
+
+	if got := RestrictTo(files, []string{filepath.Join(dir, ".", "02-modified.spec.md")}); len(got) != 1 || got[0] != inDiff {
+		t.Errorf("esperado apenas %s, obtido %v", inDiff, got)
+	}
+	if got := RestrictTo(files, []string{}); len(got) != 0 {
+		t.Errorf("lista vazia não deveria manter arquivos, obtido %v", got)
+	}
+}
@@ -10,6 +10,9 @@ import (
 
 	"github.com/dreibox/specs/internal/adapters"
 	"github.com/dreibox/specs/internal/services/config"
+	"github.com/dreibox/specs/internal/services/lister"
+	"github.com/dreibox/specs/internal/services/metadata"
+	"github.com/dreibox/specs/internal/services/numbering"
 	"github.com/dreibox/specs/internal/services/validator"
 )
 
@@ -31,7 +34,8 @@ func NewService(fs adapters.FileSystem) *Service {
 
 // ViewOptions contém opções para visualização
 type ViewOptions struct {
-	Path string
+	Path    string
+	GroupBy string // Critério de agrupamento (lister.GroupDir, GroupTag, GroupStatus ou GroupOwner); vazio = sem grupos
 }
 
 // SpecStats contém estatísticas de uma spec
@@ -44,6 +48,22 @@ type SpecStats struct {
 	Complete     bool
 	MarkedItems  int
 	TotalItems   int
+	Dir          string   // Diretório relativo ao caminho visualizado ("" = raiz)
+	Lifecycle    string   // Situação do ciclo de vida (chave status do frontmatter)
+	Owner        string
+	Tags         []string
+}
+
+// Group contém as specs de um grupo do dashboard e seus totais
+type Group struct {
+	Key             string // Diretório, tag, situação ou responsável ("" = sem valor / raiz)
+	Specs           []SpecStats
+	TotalSpecs      int
+	Requirements    int
+	SpecsComplete   int
+	SpecsInProgress int
+	Progress        float64 // 0.0 a 1.0
+	ProgressStr     string  // "X/Y (Z%)"
 }
 
 // DashboardResult contém resultado do dashboard
@@ -55,10 +75,18 @@ type DashboardResult struct {
 	OverallProgress    float64 // 0.0 a 1.0
 	OverallProgressStr string  // "X/Y (Z%)"
 	Specs              []SpecStats
+	GroupBy            string  // Critério usado em Groups (mantido no Refresh)
+	Groups             []Group // Preenchido apenas com ViewOptions.GroupBy
 }
 
 // View gera dashboard de visualização
 func (s *Service) View(opts ViewOptions) (*DashboardResult, error) {
+	if opts.GroupBy != "" {
+		if err := lister.ValidateGroupBy(opts.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+
 	// Determinar caminho
 	path := opts.Path
 	if path == "" {
@@ -90,30 +118,92 @@ func (s *Service) View(opts ViewOptions) (*DashboardResult, error) {
 		return nil, fmt.Errorf("falha ao listar arquivos: %w", err)
 	}
 
-	result := &DashboardResult{
-		Specs: make([]SpecStats, 0, len(specFiles)),
+	excludeTemplates := s.excludeTemplates()
+	scheme := s.numbering()
+
+	// Processar cada spec (excluindo templates se configurado)
+	specs := make([]SpecStats, 0, len(specFiles))
+	for _, file := range specFiles {
+		// Excluir specs de template se configurado
+		if excludeTemplates && s.isTemplateSpec(file) {
+			continue
+		}
+
+		specs = append(specs, s.getSpecStats(file, path, scheme))
+	}
+
+	return summarize(specs, scheme, opts.GroupBy), nil
+}
+
+// Refresh recalcula estatísticas apenas das specs alteradas, reaproveitando as demais.
+// Specs alteradas que não existem mais são removidas do dashboard.
+func (s *Service) Refresh(previous *DashboardResult, basePath string, changed []string) *DashboardResult {
+	excludeTemplates := s.excludeTemplates()
+	scheme := s.numbering()
+
+	changedSet := make(map[string]bool, len(changed))
+	for _, file := range changed {
+		changedSet[filepath.Clean(file)] = true
+	}
+
+	specs := make([]SpecStats, 0, len(previous.Specs)+len(changed))
+	seen := make(map[string]bool)
+	for _, stats := range previous.Specs {
+		path := filepath.Clean(stats.Path)
+		seen[path] = true
+		if !changedSet[path] {
+			specs = append(specs, stats)
+			continue
+		}
+		if s.fs.Exists(stats.Path) {
+			specs = append(specs, s.getSpecStats(stats.Path, basePath, scheme))
+		}
 	}
 
-	// Verificar configuração para excluir templates
+	// Specs novas
+	for _, file := range changed {
+		if seen[filepath.Clean(file)] || !strings.HasSuffix(file, ".spec.md") || !s.fs.Exists(file) {
+			continue
+		}
+		if excludeTemplates && s.isTemplateSpec(file) {
+			continue
+		}
+		specs = append(specs, s.getSpecStats(file, basePath, scheme))
+	}
+
+	return summarize(specs, scheme, previous.GroupBy)
+}
+
+// excludeTemplates verifica configuração para excluir templates
+func (s *Service) excludeTemplates() bool {
 	cfg, err := s.configSvc.Load()
 	if err != nil {
 		// Em caso de erro, usar padrão (excluir templates)
 		cfg = config.DefaultConfig()
 	}
-	excludeTemplates := cfg.Specs.ExcludeTemplates
+	return cfg.Specs.ExcludeTemplates
+}
+
+// numbering retorna o esquema de numeração configurado (padrão se ausente ou inválido)
+func (s *Service) numbering() numbering.Scheme {
+	scheme, err := s.configSvc.NumberingScheme()
+	if err != nil {
+		return numbering.Scheme{}
+	}
+	return scheme
+}
+
+// summarize agrega estatísticas das specs em um DashboardResult
+func summarize(specs []SpecStats, scheme numbering.Scheme, groupBy string) *DashboardResult {
+	result := &DashboardResult{
+		Specs:   specs,
+		GroupBy: groupBy,
+	}
 
-	// Processar cada spec (excluindo templates se configurado)
 	totalMarkedItems := 0
 	totalPossibleItems := 0
 
-	for _, file := range specFiles {
-		// Excluir specs de template se configurado
-		if excludeTemplates && s.isTemplateSpec(file) {
-			continue
-		}
-
-		stats := s.getSpecStats(file, path)
-		result.Specs = append(result.Specs, stats)
+	for _, stats := range specs {
 		result.TotalSpecs++
 
 		result.TotalRequirements += stats.Requirements
@@ -128,20 +218,66 @@ func (s *Service) View(opts ViewOptions) (*DashboardResult, error) {
 	}
 
 	// Calcular progresso geral
-	if totalPossibleItems > 0 {
-		result.OverallProgress = float64(totalMarkedItems) / float64(totalPossibleItems)
-		percent := int(result.OverallProgress * 100)
-		result.OverallProgressStr = fmt.Sprintf("%d/%d (%d%% complete)", totalMarkedItems, totalPossibleItems, percent)
-	} else {
-		result.OverallProgressStr = "0/0 (0% complete)"
-	}
+	result.OverallProgress, result.OverallProgressStr = progress(totalMarkedItems, totalPossibleItems)
 
 	// Ordenar specs por numeração
 	sort.Slice(result.Specs, func(i, j int) bool {
-		return result.Specs[i].Number < result.Specs[j].Number
+		return scheme.CompareFiles(result.Specs[i].Path, result.Specs[j].Path) < 0
 	})
 
-	return result, nil
+	if groupBy != "" {
+		result.Groups = groupStats(result.Specs, groupBy)
+	}
+
+	return result
+}
+
+// progress calcula a fração de itens marcados e sua descrição ("X/Y (Z% complete)")
+func progress(marked, total int) (float64, string) {
+	if total == 0 {
+		return 0, "0/0 (0% complete)"
+	}
+	fraction := float64(marked) / float64(total)
+	return fraction, fmt.Sprintf("%d/%d (%d%% complete)", marked, total, int(fraction*100))
+}
+
+// groupStats agrupa as specs (em ordem de numeração dentro de cada grupo) e calcula os
+// totais e o progresso de cada grupo
+func groupStats(specs []SpecStats, groupBy string) []Group {
+	index := make(map[string]*Group)
+	var keys []string
+	for _, stats := range specs {
+		for _, key := range lister.GroupKeys(groupBy, stats.Dir, stats.Lifecycle, stats.Owner, stats.Tags) {
+			group, ok := index[key]
+			if !ok {
+				group = &Group{Key: key}
+				index[key] = group
+				keys = append(keys, key)
+			}
+			group.Specs = append(group.Specs, stats)
+		}
+	}
+	lister.SortGroupKeys(groupBy, keys)
+
+	groups := make([]Group, 0, len(keys))
+	for _, key := range keys {
+		group := index[key]
+		marked, total := 0, 0
+		for _, stats := range group.Specs {
+			group.TotalSpecs++
+			group.Requirements += stats.Requirements
+			if stats.Complete {
+				group.SpecsComplete++
+			} else {
+				group.SpecsInProgress++
+			}
+			marked += stats.MarkedItems
+			total += stats.TotalItems
+		}
+		group.Progress, group.ProgressStr = progress(marked, total)
+		groups = append(groups, *group)
+	}
+	return groups
 }
 
 // findSpecFiles encontra todos os arquivos .spec.md recursivamente
@@ -160,7 +296,7 @@ func (s *Service) findSpecFiles(root string) ([]string, error) {
 }
 
 // getSpecStats obtém estatísticas de uma spec
-func (s *Service) getSpecStats(filePath string, basePath string) SpecStats {
+func (s *Service) getSpecStats(filePath string, basePath string, scheme numbering.Scheme) SpecStats {
 	// Extrair numeração e nome
 	fileName := filepath.Base(filePath)
 	nameWithoutExt := strings.TrimSuffix(fileName, ".spec.md")
@@ -168,7 +304,10 @@ func (s *Service) getSpecStats(filePath string, basePath string) SpecStats {
 	parts := strings.SplitN(nameWithoutExt, "-", 2)
 	number := ""
 	name := nameWithoutExt
-	if len(parts) >= 2 {
+	if n, specName, ok := scheme.Split(fileName); ok {
+		number = scheme.Format(n)
+		name = specName
+	} else if len(parts) >= 2 {
 		number = parts[0]
 		name = strings.Join(parts[1:], "-")
 	}
@@ -188,6 +327,15 @@ func (s *Service) getSpecStats(filePath string, basePath string) SpecStats {
 
 	content := string(data)
 
+	// Diretório e metadados usados no agrupamento
+	if rel, err := filepath.Rel(basePath, filepath.Dir(filePath)); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+		stats.Dir = filepath.ToSlash(rel)
+	}
+	meta := metadata.Parse(content)
+	stats.Lifecycle = meta.Status
+	stats.Owner = meta.Owner
+	stats.Tags = meta.Tags
+
 	// Contar requirements
 	stats.Requirements = s.countRequirements(content)
 
@@ -1,6 +1,7 @@
 package viewer
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -313,3 +314,116 @@ Teste
 		}
 	}
 }
+
+func TestService_Refresh(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	tmpDir := t.TempDir()
+	specsDir := filepath.Join(tmpDir, "specs")
+	if err := fs.MkdirAll(specsDir, 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+
+	spec1 := filepath.Join(specsDir, "01-test.spec.md")
+	spec2 := filepath.Join(specsDir, "02-other.spec.md")
+	withOneRF := "# 01 Test\n\n## 2. Requisitos Funcionais\n\n- **RF01 - Teste:**\n"
+	withTwoRF := withOneRF + "- **RF02 - Outro:**\n"
+
+	if err := fs.WriteFile(spec1, []byte(withOneRF), 0644); err != nil {
+		t.Fatalf("falha ao criar spec1: %v", err)
+	}
+
+	result, err := service.View(ViewOptions{Path: specsDir})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if result.TotalRequirements != 1 {
+		t.Fatalf("esperado 1 requirement, obtido %d", result.TotalRequirements)
+	}
+
+	// Alterar spec1 e criar spec2
+	if err := fs.WriteFile(spec1, []byte(withTwoRF), 0644); err != nil {
+		t.Fatalf("falha ao alterar spec1: %v", err)
+	}
+	if err := fs.WriteFile(spec2, []byte(withOneRF), 0644); err != nil {
+		t.Fatalf("falha ao criar spec2: %v", err)
+	}
+
+	updated := service.Refresh(result, specsDir, []string{spec1, spec2})
+	if updated.TotalSpecs != 2 {
+		t.Errorf("esperado 2 specs, obtido %d", updated.TotalSpecs)
+	}
+	if updated.TotalRequirements != 3 {
+		t.Errorf("esperado 3 requirements, obtido %d", updated.TotalRequirements)
+	}
+}
+
+func TestService_View_GroupBy(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	service := NewService(fs)
+
+	specsDir := t.TempDir()
+	if err := fs.MkdirAll(filepath.Join(specsDir, "api"), 0755); err != nil {
+		t.Fatalf("falha ao criar diretório: %v", err)
+	}
+	specs := map[string]string{
+		"01-a.spec.md":     "---\nowner: ana\ntags: [cli, api]\n---\n# 01 A\n\n## 2. Requisitos Funcionais\n\n- **RF01 - Um:**\n",
+		"02-b.spec.md":     "---\nowner: bruno\nstatus: draft\n---\n# 02 B\n",
+		"api/03-c.spec.md": "---\nowner: ana\ntags: [api]\n---\n# 03 C\n\n## 2. Requisitos Funcionais\n\n- **RF01 - Um:**\n- **RF02 - Dois:**\n",
+	}
+	for name, content := range specs {
+		if err := fs.WriteFile(filepath.Join(specsDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("falha ao criar %s: %v", name, err)
+		}
+	}
+
+	describe := func(groups []Group) string {
+		var parts []string
+		for _, group := range groups {
+			var names []string
+			for _, spec := range group.Specs {
+				names = append(names, spec.Name)
+			}
+			parts = append(parts, fmt.Sprintf("%s=%s/%d", group.Key, strings.Join(names, ","), group.Requirements))
+		}
+		return strings.Join(parts, " ")
+	}
+
+	tests := []struct {
+		groupBy  string
+		expected string
+	}{
+		{"dir", "=a,b/1 api=c/2"},
+		{"tag", "api=a,c/3 cli=a/1 =b/0"},
+		{"owner", "ana=a,c/3 bruno=b/0"},
+		{"status", "draft=b/0 =a,c/3"},
+	}
+	for _, tt := range tests {
+		result, err := service.View(ViewOptions{Path: specsDir, GroupBy: tt.groupBy})
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if got := describe(result.Groups); got != tt.expected {
+			t.Errorf("GroupBy %s: obtido %q, esperado %q", tt.groupBy, got, tt.expected)
+		}
+		if result.TotalSpecs != 3 {
+			t.Errorf("GroupBy %s: totais devem contar cada spec uma vez, obtido %d", tt.groupBy, result.TotalSpecs)
+		}
+	}
+
+	// Refresh mantém o agrupamento
+	result, _ := service.View(ViewOptions{Path: specsDir, GroupBy: "owner"})
+	changed := filepath.Join(specsDir, "02-b.spec.md")
+	if err := fs.WriteFile(changed, []byte("---\nowner: ana\n---\n# 02 B\n"), 0644); err != nil {
+		t.Fatalf("falha ao alterar spec: %v", err)
+	}
+	updated := service.Refresh(result, specsDir, []string{changed})
+	if got := describe(updated.Groups); got != "ana=a,b,c/3" {
+		t.Errorf("Refresh: obtido %q", got)
+	}
+
+	if _, err := service.View(ViewOptions{Path: specsDir, GroupBy: "cor"}); err == nil {
+		t.Error("critério de agrupamento inválido deveria retornar erro")
+	}
+}
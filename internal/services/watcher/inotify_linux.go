@@ -0,0 +1,140 @@
+//go:build linux
+
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_CLOSE_WRITE | syscall.IN_MODIFY |
+	syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO
+
+// inotifyNotifier usa inotify para receber eventos do kernel
+type inotifyNotifier struct {
+	file   *os.File
+	fd     int
+	events chan string
+	done   chan struct{}
+	mu     sync.Mutex
+	dirs   map[int]string // watch descriptor -> diretório
+	once   sync.Once
+}
+
+// newOSNotifier cria notificador baseado em inotify
+func newOSNotifier(root string) (notifier, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &inotifyNotifier{
+		// Descritor não bloqueante permite que Close interrompa a leitura
+		file:   os.NewFile(uintptr(fd), "inotify"),
+		fd:     fd,
+		events: make(chan string),
+		done:   make(chan struct{}),
+		dirs:   make(map[int]string),
+	}
+
+	if err := n.addRecursive(root); err != nil {
+		n.file.Close()
+		return nil, err
+	}
+
+	go n.loop()
+	return n, nil
+}
+
+func (n *inotifyNotifier) Events() <-chan string {
+	return n.events
+}
+
+func (n *inotifyNotifier) Close() error {
+	var err error
+	n.once.Do(func() {
+		close(n.done)
+		err = n.file.Close()
+	})
+	return err
+}
+
+// addRecursive adiciona watch para o diretório e todos os subdiretórios
+func (n *inotifyNotifier) addRecursive(root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		wd, err := syscall.InotifyAddWatch(n.fd, path, inotifyMask)
+		if err != nil {
+			return err
+		}
+		n.mu.Lock()
+		n.dirs[wd] = path
+		n.mu.Unlock()
+		return nil
+	})
+}
+
+// loop lê eventos do kernel e emite caminhos afetados
+func (n *inotifyNotifier) loop() {
+	defer close(n.events)
+
+	buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+	for {
+		count, err := n.file.Read(buf)
+		if err != nil {
+			return
+		}
+
+		offset := 0
+		for offset+syscall.SizeofInotifyEvent <= count {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + syscall.SizeofInotifyEvent
+			nameEnd := nameStart + int(event.Len)
+			if nameEnd > count {
+				break
+			}
+			name := string(trimNull(buf[nameStart:nameEnd]))
+			offset = nameEnd
+
+			n.mu.Lock()
+			dir, ok := n.dirs[int(event.Wd)]
+			n.mu.Unlock()
+			if !ok || name == "" {
+				continue
+			}
+			path := filepath.Join(dir, name)
+
+			// Novos diretórios também precisam ser monitorados
+			if event.Mask&syscall.IN_ISDIR != 0 {
+				if event.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+					n.addRecursive(path)
+				}
+				continue
+			}
+
+			select {
+			case n.events <- path:
+			case <-n.done:
+				return
+			}
+		}
+	}
+}
+
+// trimNull remove o preenchimento de bytes nulos do nome do evento
+func trimNull(b []byte) []byte {
+	for i, c := range b {
+		if c == 0 {
+			return b[:i]
+		}
+	}
+	return b
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package watcher
+
+import "errors"
+
+// newOSNotifier não está disponível fora do Linux; o monitoramento usa polling
+func newOSNotifier(root string) (notifier, error) {
+	return nil, errors.New("notificações do sistema não suportadas nesta plataforma")
+}
@@ -0,0 +1,225 @@
+package watcher
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+const (
+	// DefaultDebounce é o intervalo de silêncio aguardado antes de notificar alterações
+	DefaultDebounce = 300 * time.Millisecond
+	// DefaultPollInterval é o intervalo entre varreduras no modo polling
+	DefaultPollInterval = 500 * time.Millisecond
+)
+
+// Service gerencia monitoramento de alterações em specs
+type Service struct {
+	fs adapters.FileSystem
+}
+
+// NewService cria uma nova instância do Service
+func NewService(fs adapters.FileSystem) *Service {
+	return &Service{fs: fs}
+}
+
+// WatchOptions contém opções para monitoramento
+type WatchOptions struct {
+	Path         string        // Diretório monitorado (recursivo)
+	Debounce     time.Duration // Janela para agrupar rajadas de eventos
+	PollInterval time.Duration // Intervalo de varredura no modo polling
+	ForcePolling bool          // Ignora notificações do sistema operacional
+}
+
+// notifier é a fonte de eventos brutos (caminhos alterados)
+type notifier interface {
+	Events() <-chan string
+	Close() error
+}
+
+// Watch monitora o diretório e chama onChange com os arquivos .spec.md alterados.
+// Bloqueia até que stop seja fechado.
+func (s *Service) Watch(opts WatchOptions, stop <-chan struct{}, onChange func(changed []string)) error {
+	if opts.Path == "" {
+		return fmt.Errorf("caminho não informado")
+	}
+	if !s.fs.Exists(opts.Path) {
+		return fmt.Errorf("caminho não existe: %s", opts.Path)
+	}
+	stat, err := s.fs.Stat(opts.Path)
+	if err != nil {
+		return fmt.Errorf("falha ao obter informações do caminho: %w", err)
+	}
+	if !stat.IsDir() {
+		return fmt.Errorf("caminho não é diretório: %s", opts.Path)
+	}
+
+	debounce := opts.Debounce
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	var n notifier
+	if !opts.ForcePolling {
+		n, err = newOSNotifier(opts.Path)
+	}
+	if opts.ForcePolling || err != nil {
+		n, err = newPollNotifier(s.fs, opts.Path, interval)
+		if err != nil {
+			return fmt.Errorf("falha ao iniciar monitoramento: %w", err)
+		}
+	}
+	defer n.Close()
+
+	pending := make(map[string]bool)
+	var timer *time.Timer
+	var fire <-chan time.Time
+
+	for {
+		select {
+		case <-stop:
+			if timer != nil {
+				timer.Stop()
+			}
+			return nil
+		case path, ok := <-n.Events():
+			if !ok {
+				return nil
+			}
+			if !strings.HasSuffix(path, ".spec.md") {
+				continue
+			}
+			pending[path] = true
+			// Reiniciar janela de debounce a cada evento
+			if timer == nil {
+				timer = time.NewTimer(debounce)
+			} else {
+				if !timer.Stop() {
+					select {
+					case <-timer.C:
+					default:
+					}
+				}
+				timer.Reset(debounce)
+			}
+			fire = timer.C
+		case <-fire:
+			fire = nil
+			changed := make([]string, 0, len(pending))
+			for path := range pending {
+				changed = append(changed, path)
+			}
+			sort.Strings(changed)
+			pending = make(map[string]bool)
+			onChange(changed)
+		}
+	}
+}
+
+// fileState representa o estado de um arquivo observado no modo polling
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// pollNotifier detecta alterações comparando varreduras periódicas
+type pollNotifier struct {
+	fs       adapters.FileSystem
+	root     string
+	events   chan string
+	done     chan struct{}
+	snapshot map[string]fileState
+}
+
+func newPollNotifier(fs adapters.FileSystem, root string, interval time.Duration) (*pollNotifier, error) {
+	p := &pollNotifier{
+		fs:     fs,
+		root:   root,
+		events: make(chan string),
+		done:   make(chan struct{}),
+	}
+	snapshot, err := p.scan()
+	if err != nil {
+		return nil, err
+	}
+	p.snapshot = snapshot
+
+	go p.loop(interval)
+	return p, nil
+}
+
+func (p *pollNotifier) Events() <-chan string {
+	return p.events
+}
+
+func (p *pollNotifier) Close() error {
+	close(p.done)
+	return nil
+}
+
+// loop varre o diretório periodicamente e emite caminhos alterados
+func (p *pollNotifier) loop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			current, err := p.scan()
+			if err != nil {
+				continue
+			}
+			for _, path := range diffSnapshots(p.snapshot, current) {
+				select {
+				case p.events <- path:
+				case <-p.done:
+					return
+				}
+			}
+			p.snapshot = current
+		}
+	}
+}
+
+// scan registra data de modificação e tamanho de cada arquivo
+func (p *pollNotifier) scan() (map[string]fileState, error) {
+	snapshot := make(map[string]fileState)
+	err := p.fs.Walk(p.root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			snapshot[filepath.Clean(path)] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+		return nil
+	})
+	return snapshot, err
+}
+
+// diffSnapshots retorna caminhos criados, alterados ou removidos entre duas varreduras
+func diffSnapshots(previous, current map[string]fileState) []string {
+	var changed []string
+	for path, state := range current {
+		if old, ok := previous[path]; !ok || !old.modTime.Equal(state.modTime) || old.size != state.size {
+			changed = append(changed, path)
+		}
+	}
+	for path := range previous {
+		if _, ok := current[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,138 @@
+package watcher
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dreibox/specs/internal/adapters"
+)
+
+// watchChanges inicia o monitoramento e retorna canal com cada lote notificado
+func watchChanges(t *testing.T, opts WatchOptions) (<-chan []string, func()) {
+	t.Helper()
+	service := NewService(adapters.NewFileSystem())
+
+	batches := make(chan []string, 10)
+	stop := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- service.Watch(opts, stop, func(changed []string) {
+			batches <- changed
+		})
+	}()
+
+	// Aguardar inicialização do notificador
+	time.Sleep(100 * time.Millisecond)
+
+	return batches, func() {
+		close(stop)
+		if err := <-done; err != nil {
+			t.Errorf("erro inesperado: %v", err)
+		}
+	}
+}
+
+func waitBatch(t *testing.T, batches <-chan []string) []string {
+	t.Helper()
+	select {
+	case batch := <-batches:
+		return batch
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout aguardando alterações")
+		return nil
+	}
+}
+
+func TestService_Watch_Polling(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	specsDir := t.TempDir()
+	specFile := filepath.Join(specsDir, "01-test.spec.md")
+	if err := fs.WriteFile(specFile, []byte("# 01 Test"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	batches, stop := watchChanges(t, WatchOptions{
+		Path:         specsDir,
+		Debounce:     50 * time.Millisecond,
+		PollInterval: 20 * time.Millisecond,
+		ForcePolling: true,
+	})
+	defer stop()
+
+	newFile := filepath.Join(specsDir, "02-new.spec.md")
+	if err := fs.WriteFile(newFile, []byte("# 02 New"), 0644); err != nil {
+		t.Fatalf("falha ao criar spec: %v", err)
+	}
+
+	batch := waitBatch(t, batches)
+	if len(batch) != 1 || batch[0] != newFile {
+		t.Errorf("esperado [%s], recebido %v", newFile, batch)
+	}
+}
+
+func TestService_Watch_DebounceAndFilter(t *testing.T) {
+	fs := adapters.NewFileSystem()
+	specsDir := t.TempDir()
+
+	batches, stop := watchChanges(t, WatchOptions{
+		Path:     specsDir,
+		Debounce: 200 * time.Millisecond,
+	})
+	defer stop()
+
+	// Rajada de gravações (como editores fazem) deve gerar um único lote
+	specFile := filepath.Join(specsDir, "01-test.spec.md")
+	for i := 0; i < 5; i++ {
+		if err := fs.WriteFile(specFile, []byte("# 01 Test"), 0644); err != nil {
+			t.Fatalf("falha ao escrever spec: %v", err)
+		}
+		// Arquivos temporários de editores são ignorados
+		if err := fs.WriteFile(filepath.Join(specsDir, ".01-test.spec.md.swp"), []byte("x"), 0644); err != nil {
+			t.Fatalf("falha ao escrever swap: %v", err)
+		}
+	}
+
+	batch := waitBatch(t, batches)
+	if len(batch) != 1 || batch[0] != specFile {
+		t.Errorf("esperado [%s], recebido %v", specFile, batch)
+	}
+
+	select {
+	case extra := <-batches:
+		t.Errorf("não deveria haver lote adicional, recebido %v", extra)
+	case <-time.After(400 * time.Millisecond):
+	}
+}
+
+func TestService_Watch_InvalidPath(t *testing.T) {
+	service := NewService(adapters.NewFileSystem())
+
+	err := service.Watch(WatchOptions{Path: "/caminho/inexistente/12345"}, make(chan struct{}), func([]string) {})
+	if err == nil {
+		t.Error("deveria retornar erro para caminho inexistente")
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	now := time.Now()
+	previous := map[string]fileState{
+		"a.spec.md": {modTime: now, size: 1},
+		"b.spec.md": {modTime: now, size: 1},
+	}
+	current := map[string]fileState{
+		"a.spec.md": {modTime: now, size: 2},
+		"c.spec.md": {modTime: now, size: 1},
+	}
+
+	changed := diffSnapshots(previous, current)
+	expected := []string{"a.spec.md", "b.spec.md", "c.spec.md"}
+	if len(changed) != len(expected) {
+		t.Fatalf("esperado %v, recebido %v", expected, changed)
+	}
+	for i := range expected {
+		if changed[i] != expected[i] {
+			t.Errorf("esperado %v, recebido %v", expected, changed)
+		}
+	}
+}